@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// generate-machine-types prints the machine types of the CloudProfileConfig for the virtual machine sizes which are
+// available in an Azure location, including their vCPUs, memory and GPUs. It authenticates via the default Azure
+// credential chain, e.g. the environment variables AZURE_CLIENT_ID, AZURE_CLIENT_SECRET and AZURE_TENANT_ID or a
+// logged-in Azure CLI.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/machinetypes"
+)
+
+func main() {
+	var subscriptionID, location string
+	flag.StringVar(&subscriptionID, "subscription-id", os.Getenv("AZURE_SUBSCRIPTION_ID"), "the id of the subscription whose resource SKUs are listed")
+	flag.StringVar(&location, "location", "", "the location of the virtual machine sizes, e.g. westeurope")
+	flag.Parse()
+
+	if err := run(context.Background(), subscriptionID, location); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, subscriptionID, location string) error {
+	if subscriptionID == "" || location == "" {
+		return fmt.Errorf("the subscription id and the location must be set")
+	}
+
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return err
+	}
+	client, err := armcompute.NewResourceSKUsClient(subscriptionID, cred, nil)
+	if err != nil {
+		return err
+	}
+
+	var skus []*armcompute.ResourceSKU
+	pager := client.NewListPager(&armcompute.ResourceSKUsClientListOptions{Filter: to.Ptr(fmt.Sprintf("location eq '%s'", location))})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list resource SKUs: %w", err)
+		}
+		skus = append(skus, page.Value...)
+	}
+
+	out, err := yaml.Marshal(struct {
+		MachineTypes []v1alpha1.MachineType `json:"machineTypes"`
+	}{machinetypes.FromResourceSKUs(skus, location)})
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
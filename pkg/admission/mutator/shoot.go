@@ -17,6 +17,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 )
 
 // NewShootMutator returns a new instance of a shoot mutator.
@@ -31,8 +34,10 @@ type shoot struct {
 }
 
 const (
-	overlayKey = "overlay"
-	enabledKey = "enabled"
+	overlayKey           = "overlay"
+	enabledKey           = "enabled"
+	orchestrationModeKey = "orchestrationMode"
+	zonedKey             = "zoned"
 )
 
 // Mutate mutates the given shoot object.
@@ -48,10 +53,6 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		return nil
 	}
 
-	if shoot.Spec.Networking != nil && shoot.Spec.Networking.Type != nil && *shoot.Spec.Networking.Type != "cilium" {
-		return nil
-	}
-
 	// Skip if shoot is in restore or migration phase
 	if wasShootRescheduledToNewSeed(shoot) {
 		return nil
@@ -79,47 +80,100 @@ func (s *shoot) Mutate(_ context.Context, newObj, oldObj client.Object) error {
 		return nil
 	}
 
-	if shoot.Spec.Networking != nil {
-		networkConfig, err := s.decodeNetworkConfig(shoot.Spec.Networking.ProviderConfig)
+	if shoot.Spec.Networking != nil && (shoot.Spec.Networking.Type == nil || *shoot.Spec.Networking.Type == "cilium") {
+		if err := s.mutateNetworkConfig(shoot, oldShoot); err != nil {
+			return err
+		}
+	}
+
+	return s.mutateInfrastructureConfig(shoot, oldShoot)
+}
+
+func (s *shoot) mutateNetworkConfig(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	networkConfig, err := s.decodeProviderConfig(shoot.Spec.Networking.ProviderConfig)
+	if err != nil {
+		return err
+	}
+
+	if oldShoot == nil && networkConfig[overlayKey] == nil {
+		networkConfig[overlayKey] = map[string]interface{}{enabledKey: false}
+	}
+
+	if oldShoot != nil && networkConfig[overlayKey] == nil {
+		oldNetworkConfig, err := s.decodeProviderConfig(oldShoot.Spec.Networking.ProviderConfig)
 		if err != nil {
 			return err
 		}
 
-		if oldShoot == nil && networkConfig[overlayKey] == nil {
-			networkConfig[overlayKey] = map[string]interface{}{enabledKey: false}
+		if oldNetworkConfig[overlayKey] != nil {
+			networkConfig[overlayKey] = oldNetworkConfig[overlayKey]
 		}
+	}
+
+	modifiedJSON, err := json.Marshal(networkConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{
+		Raw: modifiedJSON,
+	}
+	return nil
+}
 
-		if oldShoot != nil && networkConfig[overlayKey] == nil {
-			oldNetworkConfig, err := s.decodeNetworkConfig(oldShoot.Spec.Networking.ProviderConfig)
-			if err != nil {
-				return err
-			}
+// mutateInfrastructureConfig defaults the orchestration mode of new non-zonal clusters to VMSSFlex. Existing clusters
+// keep their orchestration mode if it is unspecified in the new shoot, as they must be migrated explicitly.
+func (s *shoot) mutateInfrastructureConfig(shoot, oldShoot *gardencorev1beta1.Shoot) error {
+	if shoot.Spec.Provider.InfrastructureConfig == nil {
+		return nil
+	}
 
-			if oldNetworkConfig[overlayKey] != nil {
-				networkConfig[overlayKey] = oldNetworkConfig[overlayKey]
-			}
-		}
+	infraConfig, err := s.decodeProviderConfig(shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return err
+	}
 
-		modifiedJSON, err := json.Marshal(networkConfig)
+	if infraConfig[orchestrationModeKey] != nil {
+		return nil
+	}
+
+	if oldShoot == nil {
+		if zoned, _ := infraConfig[zonedKey].(bool); zoned || azureapihelper.HasShootVmoAlphaAnnotation(shoot.Annotations) {
+			return nil
+		}
+		infraConfig[orchestrationModeKey] = string(azureapi.OrchestrationModeVMSSFlex)
+	} else {
+		if oldShoot.Spec.Provider.InfrastructureConfig == nil {
+			return nil
+		}
+		oldInfraConfig, err := s.decodeProviderConfig(oldShoot.Spec.Provider.InfrastructureConfig)
 		if err != nil {
 			return err
 		}
-		shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{
-			Raw: modifiedJSON,
+		if oldInfraConfig[orchestrationModeKey] == nil {
+			return nil
 		}
+		infraConfig[orchestrationModeKey] = oldInfraConfig[orchestrationModeKey]
+	}
+
+	modifiedJSON, err := json.Marshal(infraConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+		Raw: modifiedJSON,
 	}
 	return nil
 }
 
-func (s *shoot) decodeNetworkConfig(network *runtime.RawExtension) (map[string]interface{}, error) {
-	var networkConfig map[string]interface{}
-	if network == nil || network.Raw == nil {
+func (s *shoot) decodeProviderConfig(config *runtime.RawExtension) (map[string]interface{}, error) {
+	var providerConfig map[string]interface{}
+	if config == nil || config.Raw == nil {
 		return map[string]interface{}{}, nil
 	}
-	if err := json.Unmarshal(network.Raw, &networkConfig); err != nil {
+	if err := json.Unmarshal(config.Raw, &providerConfig); err != nil {
 		return nil, err
 	}
-	return networkConfig, nil
+	return providerConfig, nil
 }
 
 // wasShootRescheduledToNewSeed returns true if the shoot.Spec.SeedName has been changed, but the migration operation has not started yet.
@@ -185,5 +185,81 @@ var _ = Describe("Shoot mutator", func() {
 				}))
 			})
 		})
+
+		Context("Mutate shoot infrastructure providerconfig", func() {
+			It("should default the orchestration mode of a new non-zonal shoot to VMSSFlex", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{"zoned":false}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"VMSSFlex","zoned":false}`),
+				}))
+			})
+
+			It("should not default the orchestration mode of a new zonal shoot", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{"zoned":true}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"zoned":true}`),
+				}))
+			})
+
+			It("should keep the orchestration mode of a new shoot if specified", func() {
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"AvailabilitySet"}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"AvailabilitySet"}`),
+				}))
+			})
+
+			It("should take the orchestration mode from the old shoot when unspecified in the new shoot", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"VMSSFlex"}`),
+				}
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"VMSSFlex"}`),
+				}))
+			})
+
+			It("should not default the orchestration mode of an existing shoot", func() {
+				oldShoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{}`),
+				}
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, oldShoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{}`),
+				}))
+			})
+
+			It("should default the orchestration mode although the shoot does not use cilium", func() {
+				shoot.Spec.Networking.Type = pointer.String("calico")
+				shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{
+					Raw: []byte(`{}`),
+				}
+				err := shootMutator.Mutate(ctx, shoot, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(shoot.Spec.Networking.ProviderConfig).To(BeNil())
+				Expect(shoot.Spec.Provider.InfrastructureConfig).To(Equal(&runtime.RawExtension{
+					Raw: []byte(`{"orchestrationMode":"VMSSFlex"}`),
+				}))
+			})
+		})
 	})
 })
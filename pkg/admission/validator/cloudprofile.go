@@ -47,5 +47,10 @@ func (cp *cloudProfile) Validate(_ context.Context, newObj, _ client.Object) err
 		return err
 	}
 
-	return azurevalidation.ValidateCloudProfileConfig(cpConfig, providerConfigPath).ToAggregate()
+	regions := make([]string, 0, len(cloudProfile.Spec.Regions))
+	for _, region := range cloudProfile.Spec.Regions {
+		regions = append(regions, region.Name)
+	}
+
+	return azurevalidation.ValidateCloudProfileConfig(cpConfig, regions, providerConfigPath).ToAggregate()
 }
@@ -93,10 +93,10 @@ func (s *shoot) validateCreation(_ context.Context, shoot *core.Shoot, cloudProf
 		}
 	}
 
-	return s.validateShoot(shoot, nil, infraConfig, cloudProfile, cpConfig).ToAggregate()
+	return s.validateShoot(shoot, nil, nil, infraConfig, cloudProfile, cpConfig).ToAggregate()
 }
 
-func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *api.InfrastructureConfig, cloudProfile *gardencorev1beta1.CloudProfile, cpConfig *api.ControlPlaneConfig) field.ErrorList {
+func (s *shoot) validateShoot(shoot *core.Shoot, oldWorkers []core.Worker, oldInfraConfig, infraConfig *api.InfrastructureConfig, cloudProfile *gardencorev1beta1.CloudProfile, cpConfig *api.ControlPlaneConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	// Network validation
@@ -107,24 +107,46 @@ func (s *shoot) validateShoot(shoot *core.Shoot, oldInfraConfig, infraConfig *ap
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigAgainstCloudProfile(oldInfraConfig, infraConfig, shoot.Spec.Region, cloudProfile, infraConfigPath)...)
 		// Provider validation
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfig(infraConfig, shoot.Spec.Networking, helper.HasShootVmoAlphaAnnotation(shoot.Annotations), infraConfigPath)...)
+		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigAgainstReconciler(oldInfraConfig, infraConfig, helper.HasShootFlowAnnotation(shoot.Annotations), infraConfigPath)...)
 	}
 	if cpConfig != nil {
 		allErrs = append(allErrs, azurevalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, cpConfigPath)...)
 	}
 
 	// Shoot workers
-	allErrs = append(allErrs, azurevalidation.ValidateWorkers(shoot.Spec.Provider.Workers, infraConfig, workersPath)...)
+	allErrs = append(allErrs, azurevalidation.ValidateWorkers(shoot.Spec.Provider.Workers, oldWorkers, infraConfig, workersPath)...)
+	allErrs = append(allErrs, azurevalidation.ValidateLoadBalancerMigration(shoot.Annotations, infraConfig, shoot.Spec.Provider.Workers, helper.HasShootFlowAnnotation(shoot.Annotations), metaDataPath.Child("annotations"))...)
+
+	var cloudProfileConfig *api.CloudProfileConfig
+	if cloudProfile.Spec.ProviderConfig != nil {
+		var err error
+		if cloudProfileConfig, err = decodeCloudProfileConfig(s.lenientDecoder, cloudProfile.Spec.ProviderConfig); err != nil {
+			allErrs = append(allErrs, field.InternalError(providerPath, fmt.Errorf("could not decode providerConfig of cloudProfile %q: %w", cloudProfile.Name, err)))
+		}
+	}
 
 	for i, worker := range shoot.Spec.Provider.Workers {
 		workerFldPath := workersPath.Index(i)
+		allErrs = append(allErrs, azurevalidation.ValidateWorkerAgainstCloudProfile(worker, shoot.Spec.Region, cloudProfileConfig, workerFldPath)...)
+
 		workerConfig, err := decodeWorkerConfig(s.decoder, worker.ProviderConfig)
 		if err != nil {
 			allErrs = append(allErrs, field.Invalid(workerFldPath.Child("providerConfig"), err, "invalid providerConfig"))
 		} else {
 			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfig(workerConfig, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstInfrastructure(workerConfig, infraConfig, helper.HasShootVmoAlphaAnnotation(shoot.Annotations), workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstReconciler(workerConfig, helper.HasShootFlowAnnotation(shoot.Annotations), workerFldPath.Child("providerConfig"))...)
+			if infraConfig != nil && infraConfig.Zoned && len(worker.Zones) == 0 {
+				allErrs = append(allErrs, azurevalidation.ValidateAvailabilitySetWorkerConfig(workerConfig, workerFldPath.Child("providerConfig"))...)
+			}
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstWorker(workerConfig, worker, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstResources(workerConfig, shoot.Spec.Resources, workerFldPath.Child("providerConfig"))...)
+			allErrs = append(allErrs, azurevalidation.ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, workerFldPath.Child("providerConfig"))...)
 		}
 	}
 
+	allErrs = append(allErrs, azurevalidation.ValidateWindowsWorkers(shoot.Spec.Provider.Workers, cloudProfileConfig, workersPath)...)
+
 	return allErrs
 }
 
@@ -161,10 +183,27 @@ func (s *shoot) validateUpdate(oldShoot, shoot *core.Shoot, cloudProfile *garden
 		allErrs = append(allErrs, azurevalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig, metaDataPath)...)
 	}
 
-	allErrs = append(allErrs, azurevalidation.ValidateVmoConfigUpdate(helper.HasShootVmoAlphaAnnotation(oldShoot.Annotations), helper.HasShootVmoAlphaAnnotation(shoot.Annotations), metaDataPath)...)
+	allErrs = append(allErrs, azurevalidation.ValidateVmoConfigUpdate(
+		helper.IsVmoConfigured(oldInfraConfig, helper.HasShootVmoAlphaAnnotation(oldShoot.Annotations)),
+		helper.IsVmoConfigured(infraConfig, helper.HasShootVmoAlphaAnnotation(shoot.Annotations)),
+		s.workerPoolsMigratedToVmo(oldShoot.Spec.Provider.Workers),
+		infraConfigPath.Child("orchestrationMode"),
+	)...)
+	allErrs = append(allErrs, azurevalidation.ValidateZoneMigration(oldInfraConfig, infraConfig, helper.HasShootFlowAnnotation(shoot.Annotations), infraConfigPath)...)
 	allErrs = append(allErrs, azurevalidation.ValidateWorkersUpdate(oldShoot.Spec.Provider.Workers, shoot.Spec.Provider.Workers, workersPath)...)
 
-	allErrs = append(allErrs, s.validateShoot(shoot, oldInfraConfig, infraConfig, cloudProfile, cpConfig)...)
+	allErrs = append(allErrs, s.validateShoot(shoot, oldShoot.Spec.Provider.Workers, oldInfraConfig, infraConfig, cloudProfile, cpConfig)...)
 
 	return allErrs.ToAggregate()
 }
+
+// workerPoolsMigratedToVmo returns true if the machines of all given worker pools are placed in VMOs of their own.
+func (s *shoot) workerPoolsMigratedToVmo(workers []core.Worker) bool {
+	for _, worker := range workers {
+		workerConfig, err := decodeWorkerConfig(s.lenientDecoder, worker.ProviderConfig)
+		if err != nil || !helper.IsWorkerPoolMigratedToVmo(workerConfig) {
+			return false
+		}
+	}
+	return true
+}
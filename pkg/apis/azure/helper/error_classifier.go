@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// azureErrorCodes maps the error codes returned by the Azure APIs (ARM and storage) to Gardener error codes.
+// Keys are compared case-insensitively.
+var azureErrorCodes = lowerKeys(map[string]gardencorev1beta1.ErrorCode{
+	// authentication
+	"InvalidAuthenticationToken":       gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidAuthenticationTokenTenant": gardencorev1beta1.ErrorInfraUnauthenticated,
+	"ExpiredAuthenticationToken":       gardencorev1beta1.ErrorInfraUnauthenticated,
+	"AuthenticationFailed":             gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidAuthenticationInfo":        gardencorev1beta1.ErrorInfraUnauthenticated,
+	"InvalidSubscriptionId":            gardencorev1beta1.ErrorInfraUnauthenticated,
+	"SubscriptionNotFound":             gardencorev1beta1.ErrorInfraUnauthenticated,
+
+	// authorization
+	"AuthorizationFailed":             gardencorev1beta1.ErrorInfraUnauthorized,
+	"LinkedAuthorizationFailed":       gardencorev1beta1.ErrorInfraUnauthorized,
+	"AuthorizationPermissionMismatch": gardencorev1beta1.ErrorInfraUnauthorized,
+	"AuthorizationFailure":            gardencorev1beta1.ErrorInfraUnauthorized,
+	"InsufficientAccountPermissions":  gardencorev1beta1.ErrorInfraUnauthorized,
+	"RequestDisallowedByPolicy":       gardencorev1beta1.ErrorInfraUnauthorized,
+	"SubscriptionNotRegistered":       gardencorev1beta1.ErrorInfraUnauthorized,
+	"MissingSubscriptionRegistration": gardencorev1beta1.ErrorInfraUnauthorized,
+	"DisallowedOperation":             gardencorev1beta1.ErrorInfraUnauthorized,
+
+	// quotas and rate limits
+	"SubscriptionRequestsThrottled": gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"TooManyRequests":               gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"ServerBusy":                    gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+	"QuotaExceeded":                 gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"PublicIPCountLimitReached":     gardencorev1beta1.ErrorInfraQuotaExceeded,
+	"ResourceQuotaExceeded":         gardencorev1beta1.ErrorInfraQuotaExceeded,
+
+	// capacity
+	"SkuNotAvailable":                  gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"ZonalAllocationFailed":            gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"AllocationFailed":                 gardencorev1beta1.ErrorInfraResourcesDepleted,
+	"OverconstrainedAllocationRequest": gardencorev1beta1.ErrorInfraResourcesDepleted,
+
+	// dependencies
+	"ReadOnlyDisabledSubscription":              gardencorev1beta1.ErrorInfraDependencies,
+	"Conflict":                                  gardencorev1beta1.ErrorInfraDependencies,
+	"InUseSubnetCannotBeDeleted":                gardencorev1beta1.ErrorInfraDependencies,
+	"InUseRouteTableCannotBeDeleted":            gardencorev1beta1.ErrorInfraDependencies,
+	"InUseNetworkSecurityGroupCannotBeDeleted":  gardencorev1beta1.ErrorInfraDependencies,
+	"InUseNatGatewayCannotBeDeleted":            gardencorev1beta1.ErrorInfraDependencies,
+	"PublicIPAddressInUse":                      gardencorev1beta1.ErrorInfraDependencies,
+	"VnetAddressSpaceCannotChangeDueToPeerings": gardencorev1beta1.ErrorInfraDependencies,
+	"ScopeLocked":                               gardencorev1beta1.ErrorInfraDependencies,
+	"InternalBillingError":                      gardencorev1beta1.ErrorInfraDependencies,
+	"RetryableError":                            gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"AnotherOperationInProgress":                gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"ReferencedResourceNotProvisioned":          gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"ResourceGroupBeingDeleted":                 gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"OperationPreempted":                        gardencorev1beta1.ErrorRetryableInfraDependencies,
+	"InternalServerError":                       gardencorev1beta1.ErrorRetryableInfraDependencies,
+
+	// configuration
+	"OverconstrainedZonalAllocationRequest": gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidParameter":                      gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidRequestFormat":                  gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidResourceReference":              gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidResourceName":                   gardencorev1beta1.ErrorConfigurationProblem,
+	"NetcfgInvalidSubnet":                   gardencorev1beta1.ErrorConfigurationProblem,
+	"NetcfgSubnetRangesOverlap":             gardencorev1beta1.ErrorConfigurationProblem,
+	"InvalidCIDRNotation":                   gardencorev1beta1.ErrorConfigurationProblem,
+	"LocationNotAvailableForResourceType":   gardencorev1beta1.ErrorConfigurationProblem,
+	"NoRegisteredProviderFound":             gardencorev1beta1.ErrorConfigurationProblem,
+	"PrivateEndpointNetworkPoliciesCannotBeEnabledOnPrivateEndpointSubnet":                       gardencorev1beta1.ErrorConfigurationProblem,
+	"PrivateLinkServiceNetworkPoliciesCannotBeEnabledOnPrivateLinkServiceSubnet":                 gardencorev1beta1.ErrorConfigurationProblem,
+	"LoadBalancingRuleMustDisableSNATSinceSameFrontendIPConfigurationIsReferencedByOutboundRule": gardencorev1beta1.ErrorConfigurationProblem,
+})
+
+// httpStatusCodes maps HTTP status codes to Gardener error codes. It is only consulted if the service did not return a
+// known error code.
+var httpStatusCodes = map[int]gardencorev1beta1.ErrorCode{
+	http.StatusUnauthorized:    gardencorev1beta1.ErrorInfraUnauthenticated,
+	http.StatusForbidden:       gardencorev1beta1.ErrorInfraUnauthorized,
+	http.StatusTooManyRequests: gardencorev1beta1.ErrorInfraRateLimitsExceeded,
+}
+
+func lowerKeys(m map[string]gardencorev1beta1.ErrorCode) map[string]gardencorev1beta1.ErrorCode {
+	res := make(map[string]gardencorev1beta1.ErrorCode, len(m))
+	for k, v := range m {
+		res[strings.ToLower(k)] = v
+	}
+	return res
+}
+
+// DetermineError determines the Gardener error codes for the given error and returns an error with these codes attached.
+// Errors returned by the Azure SDKs are classified by their service error code and HTTP status. The KnownCodes
+// regular expressions are only used if no code could be determined from the structured errors.
+func DetermineError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	// re-use the codes if the error was already classified.
+	var coder v1beta1helper.Coder
+	if errors.As(err, &coder) {
+		return err
+	}
+
+	codes := DetermineErrorCodes(err)
+	if len(codes) == 0 {
+		return err
+	}
+	return v1beta1helper.NewErrorWithCodes(err, codes...)
+}
+
+// DetermineErrorCodes returns the Gardener error codes for the given error. See DetermineError for details.
+func DetermineErrorCodes(err error) []gardencorev1beta1.ErrorCode {
+	if err == nil {
+		return nil
+	}
+
+	codes := sets.New[gardencorev1beta1.ErrorCode]()
+	visitErrors(err, func(e error) {
+		codes.Insert(classifyError(e)...)
+	})
+	if codes.Len() == 0 {
+		return util.DetermineErrorCodes(err, KnownCodes)
+	}
+	return sets.List(codes)
+}
+
+// classifyError returns the codes for a single error of the error tree without looking at wrapped errors.
+func classifyError(err error) []gardencorev1beta1.ErrorCode {
+	var (
+		serviceCode string
+		status      int
+	)
+
+	switch e := err.(type) {
+	case v1beta1helper.Coder:
+		return e.Codes()
+	case *azcore.ResponseError:
+		serviceCode, status = e.ErrorCode, e.StatusCode
+	case *azure.RequestError:
+		if e.ServiceError != nil {
+			serviceCode = e.ServiceError.Code
+		}
+		status = detailedErrorStatus(e.DetailedError)
+	case azure.RequestError:
+		if e.ServiceError != nil {
+			serviceCode = e.ServiceError.Code
+		}
+		status = detailedErrorStatus(e.DetailedError)
+	case *azure.ServiceError:
+		serviceCode = e.Code
+	case autorest.DetailedError:
+		serviceCode = serviceErrorCode(e.ServiceError)
+		status = detailedErrorStatus(e)
+	case *autorest.DetailedError:
+		serviceCode = serviceErrorCode(e.ServiceError)
+		status = detailedErrorStatus(*e)
+	case azblob.StorageError:
+		serviceCode = string(e.ServiceCode())
+		if resp := e.Response(); resp != nil {
+			status = resp.StatusCode
+		}
+	case *azidentity.AuthenticationFailedError:
+		return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}
+	default:
+		return nil
+	}
+
+	if code, ok := azureErrorCodes[strings.ToLower(serviceCode)]; ok {
+		// allocation failures of machines in a capacity reservation group use the same service codes as other allocation failures.
+		if code == gardencorev1beta1.ErrorInfraResourcesDepleted && capacityReservationExhaustedRegexp.MatchString(err.Error()) {
+			return []gardencorev1beta1.ErrorCode{ErrorInfraCapacityReservationExhausted}
+		}
+		return []gardencorev1beta1.ErrorCode{code}
+	}
+	if code, ok := httpStatusCodes[status]; ok {
+		return []gardencorev1beta1.ErrorCode{code}
+	}
+	return nil
+}
+
+func detailedErrorStatus(e autorest.DetailedError) int {
+	if code, ok := e.StatusCode.(int); ok && code != 0 {
+		return code
+	}
+	if e.Response != nil {
+		return e.Response.StatusCode
+	}
+	return 0
+}
+
+// serviceErrorCode extracts the error code from the raw response body of the track 1 SDK.
+func serviceErrorCode(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var res struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return ""
+	}
+	if res.Error.Code != "" {
+		return res.Error.Code
+	}
+	return res.Code
+}
+
+// visitErrors calls fn for every error in the tree of err. Besides the standard Unwrap methods, errors exposing their
+// origin via Cause are followed as well, since they do not unwrap on purpose to keep their message intact.
+func visitErrors(err error, fn func(error)) {
+	if err == nil {
+		return
+	}
+	fn(err)
+
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			visitErrors(inner, fn)
+		}
+	case interface{ WrappedErrors() []error }:
+		for _, inner := range e.WrappedErrors() {
+			visitErrors(inner, fn)
+		}
+	case interface{ Unwrap() error }:
+		visitErrors(e.Unwrap(), fn)
+	case interface{ Cause() error }:
+		visitErrors(e.Cause(), fn)
+	}
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+)
+
+type causer struct {
+	msg   string
+	cause error
+}
+
+func (c *causer) Error() string { return c.msg }
+func (c *causer) Cause() error  { return c.cause }
+
+// flowError returns the causes of a flow whose single task failed with the given error.
+func flowError(err error) error {
+	g := flow.NewGraph("test")
+	g.Add(flow.Task{Name: "task", Fn: func(context.Context) error { return err }})
+	return flow.Causes(g.Compile().Run(context.Background(), flow.Opts{}))
+}
+
+var _ = Describe("ErrorClassifier", func() {
+	responseError := func(status int, code string) *azcore.ResponseError {
+		return &azcore.ResponseError{
+			ErrorCode:  code,
+			StatusCode: status,
+			RawResponse: &http.Response{
+				StatusCode: status,
+				Body:       http.NoBody,
+				Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Scheme: "https", Host: "management.azure.com"}},
+			},
+		}
+	}
+
+	withBody := func(err *azcore.ResponseError, body string) *azcore.ResponseError {
+		err.RawResponse.Body = io.NopCloser(strings.NewReader(body))
+		return err
+	}
+
+	DescribeTable("#DetermineErrorCodes",
+		func(err error, expected []gardencorev1beta1.ErrorCode) {
+			Expect(DetermineErrorCodes(err)).To(ConsistOf(expected))
+		},
+		Entry("nil error", nil, nil),
+		Entry("ARM error code", responseError(http.StatusForbidden, "AuthorizationFailed"),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthorized}),
+		Entry("ARM error code with different casing", responseError(http.StatusConflict, "inusesubnetcannotbedeleted"),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraDependencies}),
+		Entry("unknown ARM error code falls back to the HTTP status", responseError(http.StatusTooManyRequests, "Foo"),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}),
+		Entry("wrapped ARM error", fmt.Errorf("failed to ensure vnet: %w", responseError(http.StatusBadRequest, "SkuNotAvailable")),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}),
+		Entry("ARM error behind a Cause", &causer{msg: "failed to ensure nats", cause: responseError(http.StatusBadRequest, "QuotaExceeded")},
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("joined ARM errors", errors.Join(responseError(http.StatusBadRequest, "QuotaExceeded"), responseError(http.StatusBadRequest, "InvalidParameter")),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded, gardencorev1beta1.ErrorConfigurationProblem}),
+		Entry("flow errors", flowError(&causer{msg: "task failed", cause: responseError(http.StatusBadRequest, "AnotherOperationInProgress")}),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorRetryableInfraDependencies}),
+		Entry("track 1 request error", autorest.NewErrorWithError(&azure.RequestError{ServiceError: &azure.ServiceError{Code: "InvalidAuthenticationTokenTenant"}}, "dns", "List", nil, ""),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}),
+		Entry("track 1 detailed error with service error body", autorest.DetailedError{Original: errors.New("foo"), ServiceError: []byte(`{"error": {"code": "ZonalAllocationFailed"}}`)},
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}),
+		Entry("track 1 detailed error with status only", autorest.DetailedError{Original: errors.New("foo"), StatusCode: http.StatusUnauthorized},
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}),
+		Entry("error with codes", v1beta1helper.NewErrorWithCodes(errors.New("foo"), gardencorev1beta1.ErrorConfigurationProblem),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}),
+		Entry("unstructured error falls back to the regular expressions", errors.New("Quota exceeded for resource"),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}),
+		Entry("unstructured error about exhausted dedicated hosts", errors.New("machine creation failed: Allocation failed. There is not enough capacity on the dedicated host to allocate the VM"),
+			[]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraResourcesDepleted}),
+		Entry("ARM allocation failure in a capacity reservation", withBody(responseError(http.StatusConflict, "AllocationFailed"), `{"error": {"code": "AllocationFailed", "message": "The capacity reservation group has insufficient capacity for the requested VM size."}}`),
+			[]gardencorev1beta1.ErrorCode{ErrorInfraCapacityReservationExhausted}),
+		Entry("unstructured error about an exhausted capacity reservation", errors.New("machine creation failed: ZonalAllocationFailed: capacity reservation is exhausted"),
+			[]gardencorev1beta1.ErrorCode{ErrorInfraCapacityReservationExhausted}),
+		Entry("unknown error", errors.New("foo"), nil),
+	)
+
+	Describe("#DetermineError", func() {
+		It("should not match the regular expressions if the structured error was classified", func() {
+			// the message would match the regular expression for dependencies.
+			err := fmt.Errorf("is already being used: %w", responseError(http.StatusForbidden, "AuthorizationFailed"))
+
+			var coder v1beta1helper.Coder
+			Expect(errors.As(DetermineError(err), &coder)).To(BeTrue())
+			Expect(coder.Codes()).To(ConsistOf(gardencorev1beta1.ErrorInfraUnauthorized))
+		})
+
+		It("should return unknown errors unchanged", func() {
+			err := errors.New("foo")
+			Expect(DetermineError(err)).To(BeIdenticalTo(err))
+		})
+	})
+})
@@ -10,6 +10,11 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
+// ErrorInfraCapacityReservationExhausted indicates that machines could not be allocated because the capacity reservation
+// group of the worker pool has no remaining capacity. It is reported instead of ErrorInfraResourcesDepleted, so that an
+// exhausted reservation can be told apart from a lack of capacity in the region or zone.
+const ErrorInfraCapacityReservationExhausted gardencorev1beta1.ErrorCode = "ERR_INFRA_CAPACITY_RESERVATION_EXHAUSTED"
+
 var (
 	unauthenticatedRegexp               = regexp.MustCompile(`(?i)(InvalidAuthenticationTokenTenant|Authentication failed|invalid character|invalid_client|InvalidAccessKeyId|cannot fetch token|InvalidSecretAccessKey|InvalidSubscriptionId)`)
 	unauthorizedRegexp                  = regexp.MustCompile(`(?i)(Unauthorized|SignatureDoesNotMatch|AuthorizationFailed|invalid_grant|Authorization Profile was not found|no active subscriptions|not authorized|AccessDenied|OperationNotAllowed)`)
@@ -17,7 +22,8 @@ var (
 	rateLimitsExceededRegexp            = regexp.MustCompile(`(?i)(RequestLimitExceeded|Throttling|Too many requests)`)
 	dependenciesRegexp                  = regexp.MustCompile(`(?i)(PendingVerification|Access Not Configured|accessNotConfigured|DependencyViolation|OptInRequired|Conflict|inactive billing state|ReadOnlyDisabledSubscription|is already being used|InUseSubnetCannotBeDeleted|VnetInUse|InUseRouteTableCannotBeDeleted|timeout while waiting for state to become|InvalidCidrBlock|already busy for|InternalServerError|internal server error|A resource with the ID|VnetAddressSpaceCannotChangeDueToPeerings|InternalBillingError)`)
 	retryableDependenciesRegexp         = regexp.MustCompile(`(?i)(RetryableError)`)
-	resourcesDepletedRegexp             = regexp.MustCompile(`(?i)(not available in the current hardware cluster|SkuNotAvailable|ZonalAllocationFailed|out of stock)`)
+	resourcesDepletedRegexp             = regexp.MustCompile(`(?i)(not available in the current hardware cluster|SkuNotAvailable|ZonalAllocationFailed|out of stock|dedicated hosts? .{0,80}capacity|capacity .{0,80}dedicated hosts?)`)
+	capacityReservationExhaustedRegexp  = regexp.MustCompile(`(?i)(capacity reservation.{0,80}(exhausted|insufficient|not enough|no remaining|fully (used|utilized|allocated))|CapacityReservation\w*(Exhausted|Exceeded|Insufficient))`)
 	configurationProblemRegexp          = regexp.MustCompile(`(?i)(AzureBastionSubnet|not supported in your requested Availability Zone|InvalidParameter|notFound|NetcfgInvalidSubnet|Invalid value|violates constraint|no attached internet gateway found|Your query returned no results|PrivateEndpointNetworkPoliciesCannotBeEnabledOnPrivateEndpointSubnet|invalid VPC attributes|PrivateLinkServiceNetworkPoliciesCannotBeEnabledOnPrivateLinkServiceSubnet|unrecognized feature gate|runtime-config invalid key|LoadBalancingRuleMustDisableSNATSinceSameFrontendIPConfigurationIsReferencedByOutboundRule|strict decoder error|not allowed to configure an unsupported|error during apply of object .* is invalid:|OverconstrainedZonalAllocationRequest|duplicate zones|overlapping zones)`)
 	retryableConfigurationProblemRegexp = regexp.MustCompile(`(?i)(is misconfigured and requires zero voluntary evictions|SDK.CanNotResolveEndpoint|The requested configuration is currently not supported)`)
 
@@ -29,8 +35,13 @@ var (
 		gardencorev1beta1.ErrorInfraRateLimitsExceeded:       rateLimitsExceededRegexp.MatchString,
 		gardencorev1beta1.ErrorInfraDependencies:             dependenciesRegexp.MatchString,
 		gardencorev1beta1.ErrorRetryableInfraDependencies:    retryableDependenciesRegexp.MatchString,
-		gardencorev1beta1.ErrorInfraResourcesDepleted:        resourcesDepletedRegexp.MatchString,
+		gardencorev1beta1.ErrorInfraResourcesDepleted:        isResourcesDepleted,
+		ErrorInfraCapacityReservationExhausted:               capacityReservationExhaustedRegexp.MatchString,
 		gardencorev1beta1.ErrorConfigurationProblem:          configurationProblemRegexp.MatchString,
 		gardencorev1beta1.ErrorRetryableConfigurationProblem: retryableConfigurationProblemRegexp.MatchString,
 	}
 )
+
+func isResourcesDepleted(message string) bool {
+	return resourcesDepletedRegexp.MatchString(message) && !capacityReservationExhaustedRegexp.MatchString(message)
+}
@@ -6,6 +6,8 @@ package helper
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"k8s.io/utils/pointer"
@@ -32,6 +34,17 @@ func FindSubnetByPurposeAndZone(subnets []api.Subnet, purpose api.Purpose, zone
 	return 0, nil, fmt.Errorf(errMsg)
 }
 
+// FindAdditionalSubnetByName takes a list of subnets and tries to find the additional subnet with the given name.
+// If no such entry is found then an error will be returned.
+func FindAdditionalSubnetByName(subnets []api.Subnet, name string) (*api.Subnet, error) {
+	for _, subnet := range subnets {
+		if subnet.Purpose == api.PurposeAdditionalNodes && subnet.AdditionalSubnetName != nil && *subnet.AdditionalSubnetName == name {
+			return &subnet, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find additional subnet %q", name)
+}
+
 // FindSecurityGroupByPurpose takes a list of security groups and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -83,6 +96,12 @@ func FindMachineImage(machineImages []api.MachineImage, name, version string, ar
 	return nil, fmt.Errorf("no machine image found with name %q, architecture %q and version %q", name, *architecture, version)
 }
 
+// IsWindowsMachineImage returns true if the given machine image is a Windows Server image. Images without an operating
+// system are Linux images.
+func IsWindowsMachineImage(machineImage *api.MachineImage) bool {
+	return machineImage.OperatingSystem != nil && *machineImage.OperatingSystem == api.OperatingSystemWindows
+}
+
 // FindDomainCountByRegion takes a region and the domain counts and finds the count for the given region.
 func FindDomainCountByRegion(domainCounts []api.DomainCount, region string) (int32, error) {
 	for _, domainCount := range domainCounts {
@@ -94,27 +113,43 @@ func FindDomainCountByRegion(domainCounts []api.DomainCount, region string) (int
 }
 
 // FindImageFromCloudProfile takes a list of machine images, and the desired image name and version. It tries
-// to find the image with the given name, architecture and version. If it cannot be found then an error
-// is returned.
-func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion string, architecture *string) (*api.MachineImage, error) {
+// to find the image with the given name, architecture and version. The image reference of the given region takes
+// precedence over the one of the version. If it cannot be found then an error is returned.
+func FindImageFromCloudProfile(cloudProfileConfig *api.CloudProfileConfig, imageName, imageVersion string, architecture *string, region string) (*api.MachineImage, error) {
 	if cloudProfileConfig != nil {
 		for _, machineImage := range cloudProfileConfig.MachineImages {
 			if machineImage.Name != imageName {
 				continue
 			}
 			for _, version := range machineImage.Versions {
-				if imageVersion == version.Version && pointer.StringEqual(architecture, version.Architecture) {
-					return &api.MachineImage{
-						Name:                    imageName,
-						Version:                 version.Version,
-						URN:                     version.URN,
-						ID:                      version.ID,
-						SharedGalleryImageID:    version.SharedGalleryImageID,
-						CommunityGalleryImageID: version.CommunityGalleryImageID,
-						AcceleratedNetworking:   version.AcceleratedNetworking,
-						Architecture:            version.Architecture,
-					}, nil
+				if imageVersion != version.Version || !pointer.StringEqual(architecture, version.Architecture) {
+					continue
+				}
+
+				image := &api.MachineImage{
+					Name:                    imageName,
+					Version:                 version.Version,
+					URN:                     version.URN,
+					ID:                      version.ID,
+					SharedGalleryImageID:    version.SharedGalleryImageID,
+					CommunityGalleryImageID: version.CommunityGalleryImageID,
+					AcceleratedNetworking:   version.AcceleratedNetworking,
+					Architecture:            version.Architecture,
+					Plan:                    version.Plan,
+					OperatingSystem:         version.OperatingSystem,
+				}
+				for _, regionImage := range version.Regions {
+					if regionImage.Name == region {
+						image.URN = regionImage.URN
+						image.ID = regionImage.ID
+						image.SharedGalleryImageID = regionImage.SharedGalleryImageID
+						image.CommunityGalleryImageID = regionImage.CommunityGalleryImageID
+					}
 				}
+				if image.URN == nil && image.ID == nil && image.SharedGalleryImageID == nil && image.CommunityGalleryImageID == nil {
+					return nil, fmt.Errorf("machine image with name %q, architecture %q and version %q is not available in region %q", imageName, *architecture, imageVersion, region)
+				}
+				return image, nil
 			}
 		}
 	}
@@ -127,6 +162,24 @@ func IsVmoRequired(infrastructureStatus *api.InfrastructureStatus) bool {
 	return !infrastructureStatus.Zoned && len(infrastructureStatus.AvailabilitySets) == 0
 }
 
+// IsVmoConfigured determines if the machines of a non-zonal cluster are configured to be placed in VMOs, either via the
+// orchestration mode of the InfrastructureConfig or via the deprecated alpha annotation of the Shoot.
+func IsVmoConfigured(config *api.InfrastructureConfig, hasVmoAlphaAnnotation bool) bool {
+	if config.Zoned {
+		return false
+	}
+	if config.OrchestrationMode != nil {
+		return *config.OrchestrationMode == api.OrchestrationModeVMSSFlex
+	}
+	return hasVmoAlphaAnnotation
+}
+
+// IsWorkerPoolMigratedToVmo determines if the machines of a worker pool are placed in a VMO although the cluster still
+// uses an availability set.
+func IsWorkerPoolMigratedToVmo(workerConfig *api.WorkerConfig) bool {
+	return workerConfig != nil && workerConfig.OrchestrationMode != nil && *workerConfig.OrchestrationMode == api.OrchestrationModeVMSSFlex
+}
+
 // HasShootVmoAlphaAnnotation determines if the passed Shoot annotations contain instruction to use VMO.
 func HasShootVmoAlphaAnnotation(shootAnnotations map[string]string) bool {
 	value, exists := shootAnnotations[azure.ShootVmoUsageAnnotation]
@@ -136,6 +189,17 @@ func HasShootVmoAlphaAnnotation(shootAnnotations map[string]string) bool {
 	return false
 }
 
+// HasShootFlowAnnotation determines if the passed Shoot annotations instruct to reconcile the infrastructure with flow.
+func HasShootFlowAnnotation(shootAnnotations map[string]string) bool {
+	return strings.EqualFold(shootAnnotations[azure.AnnotationKeyUseFlow], "true") && !strings.EqualFold(shootAnnotations[azure.AnnotationKeyUseTF], "true")
+}
+
+// HasShootLoadBalancerMigrationAnnotation determines if the passed Shoot annotations request the migration of the load
+// balancers to the standard SKU.
+func HasShootLoadBalancerMigrationAnnotation(shootAnnotations map[string]string) bool {
+	return strings.EqualFold(shootAnnotations[azure.AnnotationKeyMigrateLoadBalancers], "true")
+}
+
 // InfrastructureZoneToString translates the zone from the string format used in Gardener core objects to the int32 format used by the Azure provider extension.
 func InfrastructureZoneToString(zone int32) string {
 	return fmt.Sprintf("%d", zone)
@@ -145,3 +209,42 @@ func InfrastructureZoneToString(zone int32) string {
 func IsUsingSingleSubnetLayout(config *api.InfrastructureConfig) bool {
 	return len(config.Networks.Zones) == 0
 }
+
+// IsOSDiskType returns true if disks of the given type can be used as OS disks.
+func IsOSDiskType(diskType string) bool {
+	switch diskType {
+	case azure.DiskTypeStandardLRS, azure.DiskTypeStandardSSDLRS, azure.DiskTypeStandardSSDZRS, azure.DiskTypePremiumLRS, azure.DiskTypePremiumZRS:
+		return true
+	}
+	return false
+}
+
+// IsPremiumDiskType returns true if disks of the given type require a machine type supporting premium storage.
+func IsPremiumDiskType(diskType string) bool {
+	switch diskType {
+	case azure.DiskTypePremiumLRS, azure.DiskTypePremiumZRS, azure.DiskTypePremiumV2LRS, azure.DiskTypeUltraSSDLRS:
+		return true
+	}
+	return false
+}
+
+// HasProvisionedPerformance returns true if the IOPS and throughput of disks of the given type are provisioned
+// independently of their size. Such disks do not support host caching and can only be attached to zonal machines.
+func HasProvisionedPerformance(diskType string) bool {
+	return diskType == azure.DiskTypePremiumV2LRS || diskType == azure.DiskTypeUltraSSDLRS
+}
+
+// SecurityType returns the security type of the machines with the given security configuration, which defaults to
+// trusted launch.
+func SecurityType(security *api.SecurityConfig) api.SecurityType {
+	if security.Type == nil {
+		return api.SecurityTypeTrustedLaunch
+	}
+	return *security.Type
+}
+
+// SupportsSecurityType returns true if the given security type is contained in the supported security types of a
+// machine type or image. An empty list does not restrict the security type.
+func SupportsSecurityType(supported []api.SecurityType, securityType api.SecurityType) bool {
+	return len(supported) == 0 || slices.Contains(supported, securityType)
+}
@@ -32,6 +32,7 @@ var _ = Describe("Helper", func() {
 		boolTrue                     = true
 		boolFalse                    = false
 		zone                         = "zone"
+		confidentialVM               = api.SecurityTypeConfidentialVM
 	)
 
 	DescribeTable("#FindSubnetByPurposeAndZone",
@@ -48,6 +49,18 @@ var _ = Describe("Helper", func() {
 		Entry("entry with zone not found", []api.Subnet{{Name: "bar", Purpose: purpose, Zone: &zone}}, purpose, pointer.String("badzone"), nil, true),
 	)
 
+	DescribeTable("#FindAdditionalSubnetByName",
+		func(subnets []api.Subnet, name string, expectedSubnet *api.Subnet, expectErr bool) {
+			subnet, err := FindAdditionalSubnetByName(subnets, name)
+			expectResults(subnet, expectedSubnet, err, expectErr)
+		},
+
+		Entry("list is nil", nil, "dmz", nil, true),
+		Entry("entry of other purpose", []api.Subnet{{Name: "bar", Purpose: api.PurposeNodes}}, "dmz", nil, true),
+		Entry("entry not found", []api.Subnet{{Name: "bar", Purpose: api.PurposeAdditionalNodes, AdditionalSubnetName: pointer.String("other")}}, "dmz", nil, true),
+		Entry("entry exists", []api.Subnet{{Name: "bar", Purpose: api.PurposeAdditionalNodes, AdditionalSubnetName: pointer.String("dmz")}}, "dmz", &api.Subnet{Name: "bar", Purpose: api.PurposeAdditionalNodes, AdditionalSubnetName: pointer.String("dmz")}, false),
+	)
+
 	DescribeTable("#FindSecurityGroupByPurpose",
 		func(securityGroups []api.SecurityGroup, purpose api.Purpose, expectedSecurityGroup *api.SecurityGroup, expectErr bool) {
 			securityGroup, err := FindSecurityGroupByPurpose(securityGroups, purpose)
@@ -120,7 +133,7 @@ var _ = Describe("Helper", func() {
 		func(profileImages []api.MachineImages, imageName, version string, architecture *string, expectedImage *api.MachineImage) {
 			cfg := &api.CloudProfileConfig{}
 			cfg.MachineImages = profileImages
-			image, err := FindImageFromCloudProfile(cfg, imageName, version, architecture)
+			image, err := FindImageFromCloudProfile(cfg, imageName, version, architecture, "westeurope")
 
 			Expect(image).To(Equal(expectedImage))
 			if expectedImage != nil {
@@ -149,6 +162,15 @@ var _ = Describe("Helper", func() {
 			"ubuntu", "1", pointer.String("foo"), &api.MachineImage{Name: "ubuntu", Version: "1", CommunityGalleryImageID: &profileCommunityImageId, Architecture: pointer.String("foo")}),
 		Entry("valid image reference, only sharedGalleryImageID", makeProfileMachineImageWithURNandIDandCommunityGalleryIDandSharedGalleryImageID("ubuntu", "1", nil, nil, nil, &profileSharedImageId, pointer.String("foo")),
 			"ubuntu", "1", pointer.String("foo"), &api.MachineImage{Name: "ubuntu", Version: "1", SharedGalleryImageID: &profileSharedImageId, Architecture: pointer.String("foo")}),
+
+		Entry("region entry takes precedence", makeProfileMachineImagesWithRegions("ubuntu", "1", &profileURN, []api.RegionImage{{Name: "westeurope", SharedGalleryImageID: &profileSharedImageId}}, pointer.String("foo")),
+			"ubuntu", "1", pointer.String("foo"), &api.MachineImage{Name: "ubuntu", Version: "1", SharedGalleryImageID: &profileSharedImageId, Architecture: pointer.String("foo")}),
+		Entry("region entry of other region is ignored", makeProfileMachineImagesWithRegions("ubuntu", "1", &profileURN, []api.RegionImage{{Name: "eastus", SharedGalleryImageID: &profileSharedImageId}}, pointer.String("foo")),
+			"ubuntu", "1", pointer.String("foo"), &api.MachineImage{Name: "ubuntu", Version: "1", URN: &profileURN, Architecture: pointer.String("foo")}),
+		Entry("plan is returned", []api.MachineImages{{Name: "ubuntu", Versions: []api.MachineImageVersion{{Version: "1", URN: &profileURN, Architecture: pointer.String("foo"), Plan: &api.MachineImagePlan{Name: "plan", Product: "offer", Publisher: "publisher"}}}}},
+			"ubuntu", "1", pointer.String("foo"), &api.MachineImage{Name: "ubuntu", Version: "1", URN: &profileURN, Architecture: pointer.String("foo"), Plan: &api.MachineImagePlan{Name: "plan", Product: "offer", Publisher: "publisher"}}),
+		Entry("image not available in region", makeProfileMachineImagesWithRegions("ubuntu", "1", nil, []api.RegionImage{{Name: "eastus", SharedGalleryImageID: &profileSharedImageId}}, pointer.String("foo")),
+			"ubuntu", "1", pointer.String("foo"), nil),
 	)
 
 	DescribeTable("#IsVmoRequired",
@@ -186,6 +208,48 @@ var _ = Describe("Helper", func() {
 		Entry("should return false as shoot annotations contain vmo alpha annotation with wrong value", true, false, false),
 		Entry("should return false as shoot annotations do not contain vmo alpha annotation", false, false, false),
 	)
+
+	DescribeTable("#HasShootFlowAnnotation",
+		func(annotations map[string]string, expectedResult bool) {
+			Expect(HasShootFlowAnnotation(annotations)).To(Equal(expectedResult))
+		},
+		Entry("should return true as shoot annotations contain the flow annotation", map[string]string{azure.AnnotationKeyUseFlow: "true"}, true),
+		Entry("should return false as shoot annotations contain the flow annotation with wrong value", map[string]string{azure.AnnotationKeyUseFlow: "false"}, false),
+		Entry("should return false as shoot annotations force terraform", map[string]string{azure.AnnotationKeyUseFlow: "true", azure.AnnotationKeyUseTF: "true"}, false),
+		Entry("should return false as shoot annotations do not contain the flow annotation", nil, false),
+	)
+
+	DescribeTable("#SecurityType",
+		func(security *api.SecurityConfig, expected api.SecurityType) {
+			Expect(SecurityType(security)).To(Equal(expected))
+		},
+		Entry("should default to trusted launch", &api.SecurityConfig{}, api.SecurityTypeTrustedLaunch),
+		Entry("should return the configured type", &api.SecurityConfig{Type: &confidentialVM}, api.SecurityTypeConfidentialVM),
+	)
+
+	DescribeTable("#SupportsSecurityType",
+		func(supported []api.SecurityType, expected bool) {
+			Expect(SupportsSecurityType(supported, api.SecurityTypeConfidentialVM)).To(Equal(expected))
+		},
+		Entry("should not restrict the security type if the list is nil", nil, true),
+		Entry("should not restrict the security type if the list is empty", []api.SecurityType{}, true),
+		Entry("should support a listed security type", []api.SecurityType{api.SecurityTypeTrustedLaunch, api.SecurityTypeConfidentialVM}, true),
+		Entry("should not support a security type which is not listed", []api.SecurityType{api.SecurityTypeTrustedLaunch}, false),
+	)
+
+	DescribeTable("#disk types",
+		func(diskType string, osDisk, premium, provisionedPerformance bool) {
+			Expect(IsOSDiskType(diskType)).To(Equal(osDisk))
+			Expect(IsPremiumDiskType(diskType)).To(Equal(premium))
+			Expect(HasProvisionedPerformance(diskType)).To(Equal(provisionedPerformance))
+		},
+		Entry("Standard_LRS", "Standard_LRS", true, false, false),
+		Entry("StandardSSD_ZRS", "StandardSSD_ZRS", true, false, false),
+		Entry("Premium_ZRS", "Premium_ZRS", true, true, false),
+		Entry("PremiumV2_LRS", "PremiumV2_LRS", false, true, true),
+		Entry("UltraSSD_LRS", "UltraSSD_LRS", false, true, true),
+		Entry("unknown", "foo", false, false, false),
+	)
 })
 
 func makeProfileMachineImages(name, urnVersion, idVersion, communityGalleryImageIdVersion string, sharedGalleryImageIdVersion string, architecture *string) []api.MachineImages {
@@ -236,6 +300,22 @@ func makeProfileMachineImageWithURNandIDandCommunityGalleryIDandSharedGalleryIma
 	}
 }
 
+func makeProfileMachineImagesWithRegions(name, version string, urn *string, regions []api.RegionImage, architecture *string) []api.MachineImages {
+	return []api.MachineImages{
+		{
+			Name: name,
+			Versions: []api.MachineImageVersion{
+				{
+					Version:      version,
+					URN:          urn,
+					Regions:      regions,
+					Architecture: architecture,
+				},
+			},
+		},
+	}
+}
+
 func expectResults(result, expected interface{}, err error, expectErr bool) {
 	if !expectErr {
 		Expect(result).To(Equal(expected))
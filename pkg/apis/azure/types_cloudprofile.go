@@ -5,6 +5,7 @@
 package azure
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -57,6 +58,50 @@ type MachineImageVersion struct {
 	AcceleratedNetworking *bool
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
+	// SecurityTypes is the list of security types supported by the image. If empty, the security type is not restricted.
+	SecurityTypes []SecurityType
+	// Regions contains region-specific image references which take precedence over the image reference of the version,
+	// e.g. for shared image galleries which are only replicated to some regions.
+	Regions []RegionImage
+	// Plan is the purchase plan of a marketplace image. It must be set for marketplace images which require one, e.g.
+	// vendor-licensed or hardened images, and is only allowed for images referenced by URN.
+	Plan *MachineImagePlan
+	// OperatingSystem is the operating system of the image. Defaults to linux.
+	OperatingSystem *OperatingSystem
+}
+
+// MachineImagePlan is the purchase plan of a marketplace image.
+type MachineImagePlan struct {
+	// Name is the name of the plan.
+	Name string
+	// Product is the product of the image, i.e. the offer of its URN.
+	Product string
+	// Publisher is the publisher of the image.
+	Publisher string
+}
+
+// OperatingSystem is the operating system of a machine image.
+type OperatingSystem string
+
+const (
+	// OperatingSystemLinux is the operating system of Linux images.
+	OperatingSystemLinux OperatingSystem = "linux"
+	// OperatingSystemWindows is the operating system of Windows Server images.
+	OperatingSystemWindows OperatingSystem = "windows"
+)
+
+// RegionImage is the image reference of a machine image version in a region.
+type RegionImage struct {
+	// Name is the name of the region.
+	Name string
+	// URN is the uniform resource name of the image, it has the format 'publisher:offer:sku:version'.
+	URN *string
+	// ID is the Shared Image Gallery image id.
+	ID *string
+	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'
+	CommunityGalleryImageID *string
+	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'
+	SharedGalleryImageID *string
 }
 
 // MachineType contains provider specific information to a machine type.
@@ -65,4 +110,47 @@ type MachineType struct {
 	Name string
 	// AcceleratedNetworking is an indicator if the machine type supports Azure accelerated networking.
 	AcceleratedNetworking *bool
+	// CacheDiskSizeGB is the size of the cache disk of the machine type in GiB. Ephemeral OS disks can only be placed
+	// on the cache disk if it is at least as large as the OS disk.
+	CacheDiskSizeGB *int32
+	// ResourceDiskSizeGB is the size of the resource (temp) disk of the machine type in GiB. Ephemeral OS disks can
+	// only be placed on the resource disk if it is at least as large as the OS disk.
+	ResourceDiskSizeGB *int32
+	// PremiumIO is an indicator if the machine type supports premium storage. If it is false, premium disk types
+	// cannot be used for the volumes of the machines.
+	PremiumIO *bool
+	// UltraSSD is an indicator if the machine type supports ultra disks.
+	UltraSSD *bool
+	// MaxNetworkInterfaces is the maximum number of network interfaces which can be attached to machines of the
+	// machine type.
+	MaxNetworkInterfaces *int32
+	// EphemeralOSDisk is an indicator if the machine type supports ephemeral OS disks. Machine types are assumed to
+	// support them unless stated otherwise.
+	EphemeralOSDisk *bool
+	// MaxDataDisks is the maximum number of data disks which can be attached to machines of the machine type.
+	MaxDataDisks *int32
+	// Architectures are the CPU architectures supported by the machine type. If empty, the architecture is not restricted.
+	Architectures []string
+	// SecurityTypes are the security types supported by the machine type. If empty, the security type is not restricted.
+	SecurityTypes []SecurityType
+	// Zones are the zones per region in which the machine type is available. Regions which are not listed are not
+	// restricted.
+	Zones []MachineTypeZones
+	// CPU is the number of vCPUs of the machine type.
+	CPU *resource.Quantity
+	// Memory is the memory of the machine type.
+	Memory *resource.Quantity
+	// GPU is the number of GPUs of the machine type.
+	GPU *resource.Quantity
+	// EphemeralStorage is the ephemeral storage of nodes of the machine type. It is only used for the node template of
+	// worker pools which neither configure the size of their volume nor a kubelet data volume.
+	EphemeralStorage *resource.Quantity
+}
+
+// MachineTypeZones contains the zones of a region in which a machine type is available.
+type MachineTypeZones struct {
+	// Region is the name of the region.
+	Region string
+	// Names are the names of the zones.
+	Names []string
 }
@@ -21,6 +21,33 @@ type InfrastructureConfig struct {
 	Identity *IdentityConfig
 	// Zoned indicates whether the cluster uses zones
 	Zoned bool
+	// ResourceLocks contains the configuration for management locks on the infrastructure resources.
+	ResourceLocks *ResourceLocksConfig
+	// DiskEncryption contains the default encryption settings for the disks of all worker pools.
+	DiskEncryption *DiskEncryption
+	// BootDiagnostics contains the default boot diagnostics settings of the machines of all worker pools.
+	BootDiagnostics *BootDiagnostics
+	// OrchestrationMode is the mode in which the machines of a non-zonal cluster are orchestrated. New clusters use
+	// VMSSFlex. Clusters which use an availability set can be migrated to VMSSFlex once all of their worker pools
+	// have been migrated.
+	OrchestrationMode *OrchestrationMode
+}
+
+// OrchestrationMode is the mode in which the machines of a non-zonal cluster are orchestrated.
+type OrchestrationMode string
+
+const (
+	// OrchestrationModeAvailabilitySet places the machines of all worker pools in a shared availability set.
+	OrchestrationModeAvailabilitySet OrchestrationMode = "AvailabilitySet"
+	// OrchestrationModeVMSSFlex places the machines of each worker pool in a virtual machine scale set with flexible
+	// orchestration (VMO).
+	OrchestrationModeVMSSFlex OrchestrationMode = "VMSSFlex"
+)
+
+// ResourceLocksConfig contains the configuration for management locks on the infrastructure resources.
+type ResourceLocksConfig struct {
+	// Enabled indicates whether CanNotDelete locks are placed on the network resources created for the shoot.
+	Enabled bool
 }
 
 // ResourceGroup is azure resource group
@@ -41,8 +68,107 @@ type NetworkConfig struct {
 	ServiceEndpoints []string
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone
+	// ApplicationSecurityGroups is a list of application security groups which are created for the shoot. Worker pools
+	// can assign their machines to them and security rules can reference them.
+	ApplicationSecurityGroups []ApplicationSecurityGroupConfig
+	// SecurityRules is a list of additional rules for the network security group of the worker nodes.
+	SecurityRules []SecurityRule
+	// AdditionalSubnets is a list of subnets for the worker nodes in addition to the subnet of the workers or zones.
+	// Worker pools select them by name.
+	AdditionalSubnets []AdditionalSubnet
+}
+
+// AdditionalSubnet contains the configuration of an additional subnet for the worker nodes. It is associated with the
+// route table, the security group and the NAT gateway of the nodes subnet of its zone.
+type AdditionalSubnet struct {
+	// Name is the name of the subnet, which is used by worker pools to select it.
+	Name string
+	// CIDR is the CIDR range of the subnet.
+	CIDR string
+	// Zone is the zone of the subnet. It is required if the cluster uses a subnet per zone and forbidden otherwise.
+	Zone *int32
+	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the subnet.
+	ServiceEndpoints []string
+}
+
+// ApplicationSecurityGroupConfig contains the configuration of an application security group which is created for the
+// shoot.
+type ApplicationSecurityGroupConfig struct {
+	// Name is the name of the application security group, which is used to reference it within the shoot.
+	Name string
+}
+
+// ApplicationSecurityGroupReference references an application security group. Exactly one of the fields must be set.
+type ApplicationSecurityGroupReference struct {
+	// Name is the name of an application security group which is created for the shoot.
+	Name *string
+	// ID is the id of an existing application security group.
+	ID *string
+}
+
+// SecurityRule is a rule of the network security group of the worker nodes.
+type SecurityRule struct {
+	// Name is the name of the rule.
+	Name string
+	// Priority is the priority of the rule. Rules with a lower value are evaluated first.
+	Priority int32
+	// Direction is the direction of the traffic the rule applies to.
+	Direction SecurityRuleDirection
+	// Access determines whether the traffic is allowed or denied.
+	Access SecurityRuleAccess
+	// Protocol is the network protocol the rule applies to.
+	Protocol SecurityRuleProtocol
+	// Source is the source of the traffic.
+	Source SecurityRuleEndpoint
+	// Destination is the destination of the traffic.
+	Destination SecurityRuleEndpoint
 }
 
+// SecurityRuleEndpoint is the source or destination of a security rule. Address prefixes and application security
+// groups are mutually exclusive. If neither of them is set, the rule applies to any address.
+type SecurityRuleEndpoint struct {
+	// AddressPrefixes is a list of CIDRs or service tags.
+	AddressPrefixes []string
+	// ApplicationSecurityGroups is a list of application security groups.
+	ApplicationSecurityGroups []ApplicationSecurityGroupReference
+	// PortRanges is a list of ports or port ranges. If it is not set, the rule applies to any port.
+	PortRanges []string
+}
+
+// SecurityRuleDirection is the direction of the traffic a security rule applies to.
+type SecurityRuleDirection string
+
+const (
+	// SecurityRuleDirectionInbound is the direction of incoming traffic.
+	SecurityRuleDirectionInbound SecurityRuleDirection = "Inbound"
+	// SecurityRuleDirectionOutbound is the direction of outgoing traffic.
+	SecurityRuleDirectionOutbound SecurityRuleDirection = "Outbound"
+)
+
+// SecurityRuleAccess determines whether a security rule allows or denies traffic.
+type SecurityRuleAccess string
+
+const (
+	// SecurityRuleAccessAllow allows the traffic.
+	SecurityRuleAccessAllow SecurityRuleAccess = "Allow"
+	// SecurityRuleAccessDeny denies the traffic.
+	SecurityRuleAccessDeny SecurityRuleAccess = "Deny"
+)
+
+// SecurityRuleProtocol is the network protocol a security rule applies to.
+type SecurityRuleProtocol string
+
+const (
+	// SecurityRuleProtocolTCP is the TCP protocol.
+	SecurityRuleProtocolTCP SecurityRuleProtocol = "Tcp"
+	// SecurityRuleProtocolUDP is the UDP protocol.
+	SecurityRuleProtocolUDP SecurityRuleProtocol = "Udp"
+	// SecurityRuleProtocolICMP is the ICMP protocol.
+	SecurityRuleProtocolICMP SecurityRuleProtocol = "Icmp"
+	// SecurityRuleProtocolAny matches any protocol.
+	SecurityRuleProtocolAny SecurityRuleProtocol = "*"
+)
+
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
 type NatGatewayConfig struct {
 	// Enabled is an indicator if NAT gateway should be deployed.
@@ -110,6 +236,8 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup
+	// ApplicationSecurityGroups is a list of created application security groups.
+	ApplicationSecurityGroups []ApplicationSecurityGroup
 	// Identity is the status of the managed identity.
 	Identity *IdentityStatus
 	// Zoned indicates whether the cluster uses zones
@@ -134,6 +262,8 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposeAdditionalNodes is a Purpose for additional subnets of nodes, which are only used by selected worker pools.
+	PurposeAdditionalNodes Purpose = "additionalNodes"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -158,6 +288,9 @@ type Subnet struct {
 	// Migrated is set when the network layout is migrated from NetworkLayoutSingleSubnet to NetworkLayoutMultipleSubnet.
 	// Only the subnet that was used prior to the migration should have this attribute set.
 	Migrated bool
+	// AdditionalSubnetName is the name of the subnet in the list of additional subnets. It is only set for subnets with
+	// the PurposeAdditionalNodes purpose.
+	AdditionalSubnetName *string
 }
 
 // AvailabilitySet contains information about the azure availability set
@@ -190,6 +323,14 @@ type SecurityGroup struct {
 	Name string
 }
 
+// ApplicationSecurityGroup contains information about an application security group created for the shoot.
+type ApplicationSecurityGroup struct {
+	// Name is the name of the application security group within the shoot.
+	Name string
+	// ID is the id of the application security group.
+	ID string
+}
+
 // VNet contains information about the VNet and some related resources.
 type VNet struct {
 	// Name is the VNet name.
@@ -218,6 +359,20 @@ type IdentityConfig struct {
 	ResourceGroup string
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	ACRAccess *bool
+	// Managed indicates whether the identity is created and managed by Gardener. A managed identity is created in the
+	// shoot's resource group and deleted together with the shoot. Name and ResourceGroup must not be set in this case.
+	Managed bool
+	// RoleAssignments are the roles which are assigned to the managed identity.
+	RoleAssignments []IdentityRoleAssignment
+}
+
+// IdentityRoleAssignment describes a role which is assigned to the managed identity on a scope.
+type IdentityRoleAssignment struct {
+	// RoleDefinitionID is the ID of the role definition. It is either the fully qualified ID or only the GUID of the
+	// role definition, e.g. "7f951dda-4ed3-4680-a7ca-43fe172d538d" for AcrPull.
+	RoleDefinitionID string
+	// Scope is the ID of the subscription, resource group or resource the role is assigned on.
+	Scope string
 }
 
 // IdentityStatus contains the status information of the created managed identity.
@@ -235,6 +390,9 @@ type IdentityStatus struct {
 // InfrastructureState contains state information of the infrastructure resource.
 type InfrastructureState struct {
 	metav1.TypeMeta
+	// Version is the schema version of the state. It is empty for states written before the schema was versioned.
+	// +optional
+	Version string
 	// Data is map to store things.
 	// +optional
 	Data map[string]string
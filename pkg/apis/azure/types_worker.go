@@ -7,6 +7,7 @@ package azure
 import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -16,6 +17,224 @@ type WorkerConfig struct {
 	metav1.TypeMeta
 	// NodeTemplate contains resource information of the machine which is used by Cluster Autoscaler to generate nodeTemplate during scaling a nodeGroup from zero.
 	NodeTemplate *extensionsv1alpha1.NodeTemplate
+	// DiskEncryption contains the encryption settings for the OS and data disks of the machines. Fields which are not
+	// set fall back to the default of the InfrastructureConfig.
+	DiskEncryption *DiskEncryption
+	// Spot contains the configuration for running the machines on spot capacity. Spot machines are labeled and tainted
+	// with kubernetes.azure.com/scalesetpriority=spot, so that only workloads tolerating evictions are scheduled on them.
+	Spot *SpotConfig
+	// OSDisk contains additional configuration for the OS disk of the machines.
+	OSDisk *OSDiskConfig
+	// DataVolumes contains additional configuration for the data volumes of the worker pool.
+	DataVolumes []DataVolume
+	// Security contains the security settings of the machines, e.g. trusted launch or confidential VMs.
+	Security *SecurityConfig
+	// ProximityPlacementGroup places the machines of the worker pool in proximity placement groups which are managed
+	// by the extension, one for each zone of the worker pool.
+	ProximityPlacementGroup *ProximityPlacementGroupConfig
+	// DedicatedHost places the machines of the worker pool on Azure dedicated hosts.
+	DedicatedHost *DedicatedHostConfig
+	// CapacityReservation places the machines of the worker pool in a capacity reservation group, so that they are
+	// allocated from capacity which was reserved in advance.
+	CapacityReservation *CapacityReservationConfig
+	// ApplicationSecurityGroups is a list of application security groups the network interfaces of the machines are
+	// assigned to.
+	ApplicationSecurityGroups []ApplicationSecurityGroupReference
+	// SubnetName is the name of an additional subnet of the InfrastructureConfig the machines are placed in. If it is
+	// not set, the machines are placed in the nodes subnet of their zone.
+	SubnetName *string
+	// SecondaryIPConfigurations is the number of secondary IP configurations of the primary network interface of the
+	// machines.
+	SecondaryIPConfigurations *int32
+	// AdditionalNetworkInterfaces is a list of network interfaces which are attached to the machines in addition to the
+	// primary network interface.
+	AdditionalNetworkInterfaces []NetworkInterface
+	// OrchestrationMode migrates the machines of the worker pool of a cluster which uses an availability set to a VMO
+	// of their own. Only VMSSFlex is supported.
+	OrchestrationMode *OrchestrationMode
+	// Extensions is a list of VM extensions which are installed on the machines of the worker pool.
+	Extensions []VMExtension
+	// BootDiagnostics contains the boot diagnostics settings of the machines. It overrides the default of the
+	// InfrastructureConfig.
+	BootDiagnostics *BootDiagnostics
+}
+
+// VMExtension is a VM extension which is installed on the machines of a worker pool.
+type VMExtension struct {
+	// Name is the name of the extension.
+	Name string
+	// Publisher is the publisher of the extension handler, e.g. Microsoft.Azure.Monitor.
+	Publisher string
+	// Type is the type of the extension handler, e.g. AzureMonitorLinuxAgent.
+	Type string
+	// TypeHandlerVersion is the version of the extension handler, e.g. 1.0.
+	TypeHandlerVersion string
+	// AutoUpgradeMinorVersion indicates whether newer minor versions of the extension handler are used once they are
+	// available.
+	AutoUpgradeMinorVersion *bool
+	// Settings are the public settings of the extension.
+	Settings *runtime.RawExtension
+	// ProtectedSettingsSecretRef is the name of a resource of the Shoot which references a Secret. Its key
+	// protectedSettings contains the protected settings of the extension.
+	ProtectedSettingsSecretRef *string
+}
+
+// SpotConfig contains the configuration for running the machines of a worker pool on spot capacity.
+type SpotConfig struct {
+	// Priority is the priority of the machines.
+	Priority *VMPriority
+	// EvictionPolicy determines what happens to a machine when it is evicted.
+	EvictionPolicy *SpotEvictionPolicy
+	// MaxPrice is the maximum price in US dollars per hour which is paid for a machine. Machines are evicted if the
+	// current price exceeds it. The value -1 means that machines are only evicted for capacity reasons.
+	MaxPrice *string
+}
+
+// VMPriority is the priority of a virtual machine.
+type VMPriority string
+
+const (
+	// VMPrioritySpot is the priority of spot machines.
+	VMPrioritySpot VMPriority = "Spot"
+	// VMPriorityLow is the legacy priority of low-priority machines. Azure treats them like spot machines.
+	VMPriorityLow VMPriority = "Low"
+)
+
+// SpotEvictionPolicy is the policy applied to evicted spot machines.
+type SpotEvictionPolicy string
+
+const (
+	// SpotEvictionPolicyDelete deletes evicted machines including their disks.
+	SpotEvictionPolicyDelete SpotEvictionPolicy = "Delete"
+	// SpotEvictionPolicyDeallocate stops and deallocates evicted machines. Their disks are kept.
+	SpotEvictionPolicyDeallocate SpotEvictionPolicy = "Deallocate"
+)
+
+// OSDiskConfig contains additional configuration for the OS disk of the machines.
+type OSDiskConfig struct {
+	// EphemeralPlacement makes the OS disk an ephemeral disk which is placed on the given local storage of the machine
+	// instead of a managed disk. Ephemeral OS disks lose all data when the machine is re-imaged or moved to another host.
+	EphemeralPlacement *EphemeralOSDiskPlacement
+}
+
+// EphemeralOSDiskPlacement is the local storage of a machine on which an ephemeral OS disk is placed.
+type EphemeralOSDiskPlacement string
+
+const (
+	// EphemeralOSDiskPlacementCacheDisk places the ephemeral OS disk on the cache disk of the machine.
+	EphemeralOSDiskPlacementCacheDisk EphemeralOSDiskPlacement = "CacheDisk"
+	// EphemeralOSDiskPlacementResourceDisk places the ephemeral OS disk on the resource (temp) disk of the machine.
+	EphemeralOSDiskPlacementResourceDisk EphemeralOSDiskPlacement = "ResourceDisk"
+)
+
+// DataVolume contains additional configuration for a data volume of the worker pool.
+type DataVolume struct {
+	// Name is the name of the data volume in the worker pool.
+	Name string
+	// Caching is the host caching mode of the data disk.
+	Caching *CachingType
+	// IOPS is the number of IO operations per second provisioned for the data disk. It can only be set for the
+	// PremiumV2_LRS and UltraSSD_LRS volume types.
+	IOPS *int64
+	// ThroughputMBps is the throughput in MB per second provisioned for the data disk. It can only be set for the
+	// PremiumV2_LRS and UltraSSD_LRS volume types.
+	ThroughputMBps *int64
+}
+
+// CachingType is the host caching mode of a disk.
+type CachingType string
+
+const (
+	// CachingTypeNone disables host caching.
+	CachingTypeNone CachingType = "None"
+	// CachingTypeReadOnly enables host caching for reads.
+	CachingTypeReadOnly CachingType = "ReadOnly"
+	// CachingTypeReadWrite enables host caching for reads and writes.
+	CachingTypeReadWrite CachingType = "ReadWrite"
+)
+
+// SecurityConfig contains the security settings of the machines of a worker pool.
+type SecurityConfig struct {
+	// Type is the security type of the machines. Defaults to TrustedLaunch.
+	Type *SecurityType
+	// SecureBoot indicates whether UEFI secure boot is enabled. Defaults to true.
+	SecureBoot *bool
+	// VTPM indicates whether the virtual trusted platform module is enabled. Defaults to true. Confidential VMs
+	// always require a vTPM.
+	VTPM *bool
+	// OSDiskEncryptionType is the confidential encryption of the OS disk. It can only be set for confidential VMs and
+	// defaults to VMGuestStateOnly for them.
+	OSDiskEncryptionType *SecurityEncryptionType
+}
+
+// SecurityType is the security type of a virtual machine.
+type SecurityType string
+
+const (
+	// SecurityTypeTrustedLaunch protects the machines against boot kits and rootkits with secure boot and a vTPM.
+	SecurityTypeTrustedLaunch SecurityType = "TrustedLaunch"
+	// SecurityTypeConfidentialVM runs the machines in a hardware-based trusted execution environment.
+	SecurityTypeConfidentialVM SecurityType = "ConfidentialVM"
+)
+
+// SecurityEncryptionType is the confidential encryption of the OS disk of a confidential VM.
+type SecurityEncryptionType string
+
+const (
+	// SecurityEncryptionTypeVMGuestStateOnly encrypts only the VM guest state of the OS disk.
+	SecurityEncryptionTypeVMGuestStateOnly SecurityEncryptionType = "VMGuestStateOnly"
+	// SecurityEncryptionTypeDiskWithVMGuestState encrypts the OS disk together with the VM guest state.
+	SecurityEncryptionTypeDiskWithVMGuestState SecurityEncryptionType = "DiskWithVMGuestState"
+)
+
+// ProximityPlacementGroupConfig contains the configuration of the proximity placement groups of a worker pool.
+type ProximityPlacementGroupConfig struct{}
+
+// DedicatedHostConfig contains the configuration for placing the machines of a worker pool on dedicated hosts.
+type DedicatedHostConfig struct {
+	// HostGroupID is the id of the dedicated host group in which the machines are placed.
+	HostGroupID string
+	// HostID is the id of a dedicated host of the host group on which all machines are placed. If it is not set, Azure
+	// selects a host of the host group for each machine, which requires automatic placement for the host group.
+	HostID *string
+}
+
+// CapacityReservationConfig contains the configuration for placing the machines of a worker pool in a capacity
+// reservation group.
+type CapacityReservationConfig struct {
+	// CapacityReservationGroupID is the id of the capacity reservation group. It must contain capacity reservations for
+	// the machine type of the worker pool in all of its zones.
+	CapacityReservationGroupID string
+}
+
+// NetworkInterface contains the configuration of an additional network interface of the machines.
+type NetworkInterface struct {
+	// SubnetName is the name of an additional subnet of the InfrastructureConfig the network interface is placed in. If
+	// it is not set, the network interface is placed in the subnet of the primary network interface.
+	SubnetName *string
+	// AcceleratedNetworking can be set to false to disable accelerated networking for the network interface. By default,
+	// it is enabled if it is enabled for the primary network interface.
+	AcceleratedNetworking *bool
+	// SecondaryIPConfigurations is the number of secondary IP configurations of the network interface.
+	SecondaryIPConfigurations *int32
+}
+
+// BootDiagnostics contains the boot diagnostics settings of the machines.
+type BootDiagnostics struct {
+	// Enabled indicates whether the serial log and a screenshot of the machines are captured during boot.
+	Enabled bool
+	// StorageURI is the blob endpoint of the storage account in which the boot diagnostics are stored, e.g.
+	// https://mystorageaccount.blob.core.windows.net/. If not set, a storage account managed by Azure is used.
+	StorageURI *string
+}
+
+// DiskEncryption contains the encryption settings for the disks of the machines.
+type DiskEncryption struct {
+	// DiskEncryptionSetID is the id of the disk encryption set used to encrypt the OS and data disks with
+	// customer-managed keys.
+	DiskEncryptionSetID *string
+	// EncryptionAtHost indicates whether the temp disk and the disk caches are encrypted on the VM host.
+	EncryptionAtHost *bool
 }
 
 // +genclient
@@ -34,6 +253,23 @@ type WorkerStatus struct {
 
 	// VmoDependencies is a list of external VirtualMachineScaleSet Orchestration Mode VM (VMO) dependencies.
 	VmoDependencies []VmoDependency
+
+	// ProximityPlacementGroups is a list of proximity placement groups which are managed for the worker pools.
+	ProximityPlacementGroups []ProximityPlacementGroup
+
+	// StuckMachines is a list of machines with boot diagnostics which are stuck in creation. Their serial log can be
+	// retrieved with `az vm boot-diagnostics get-boot-log --resource-group <resourceGroup> --name <name>`.
+	StuckMachines []StuckMachine
+}
+
+// StuckMachine is a machine which did not join the cluster in time.
+type StuckMachine struct {
+	// Name is the name of the machine and its virtual machine.
+	Name string
+	// ResourceGroup is the resource group of the virtual machine.
+	ResourceGroup string
+	// Phase is the phase of the machine.
+	Phase string
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -54,6 +290,10 @@ type MachineImage struct {
 	AcceleratedNetworking *bool
 	// Architecture is the CPU architecture of the machine image.
 	Architecture *string
+	// Plan is the purchase plan of the marketplace image.
+	Plan *MachineImagePlan
+	// OperatingSystem is the operating system of the image.
+	OperatingSystem *OperatingSystem
 }
 
 // VmoDependency is dependency reference for a workerpool to a VirtualMachineScaleSet Orchestration Mode VM (VMO).
@@ -65,3 +305,15 @@ type VmoDependency struct {
 	// Name is the name of the VMO resource on Azure.
 	Name string
 }
+
+// ProximityPlacementGroup is a proximity placement group managed for the machines of a worker pool in a zone.
+type ProximityPlacementGroup struct {
+	// PoolName is the name of the worker pool to which the proximity placement group belongs to.
+	PoolName string
+	// Zone is the zone of the machines which are placed in the proximity placement group.
+	Zone string
+	// ID is the id of the proximity placement group on Azure.
+	ID string
+	// Name is the name of the proximity placement group on Azure.
+	Name string
+}
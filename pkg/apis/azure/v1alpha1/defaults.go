@@ -30,3 +30,40 @@ func SetDefaults_Storage(obj *Storage) {
 		obj.ManagedDefaultVolumeSnapshotClass = pointer.Bool(true)
 	}
 }
+
+// SetDefaults_SpotConfig sets the default priority and eviction policy of spot machines.
+func SetDefaults_SpotConfig(obj *SpotConfig) {
+	if obj.Priority == nil {
+		priority := VMPrioritySpot
+		obj.Priority = &priority
+	}
+	if obj.EvictionPolicy == nil {
+		evictionPolicy := SpotEvictionPolicyDelete
+		obj.EvictionPolicy = &evictionPolicy
+	}
+}
+
+// SetDefaults_SecurityConfig sets the default security type of the machines and enables secure boot and the vTPM.
+func SetDefaults_SecurityConfig(obj *SecurityConfig) {
+	if obj.Type == nil {
+		securityType := SecurityTypeTrustedLaunch
+		obj.Type = &securityType
+	}
+	if obj.SecureBoot == nil {
+		obj.SecureBoot = pointer.Bool(true)
+	}
+	if obj.VTPM == nil {
+		obj.VTPM = pointer.Bool(true)
+	}
+	if *obj.Type == SecurityTypeConfidentialVM && obj.OSDiskEncryptionType == nil {
+		encryptionType := SecurityEncryptionTypeVMGuestStateOnly
+		obj.OSDiskEncryptionType = &encryptionType
+	}
+}
+
+// SetDefaults_VMExtension enables the automatic upgrade of minor versions of the extension handler.
+func SetDefaults_VMExtension(obj *VMExtension) {
+	if obj.AutoUpgradeMinorVersion == nil {
+		obj.AutoUpgradeMinorVersion = pointer.Bool(true)
+	}
+}
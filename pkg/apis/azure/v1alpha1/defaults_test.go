@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
+	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
 )
@@ -34,4 +35,65 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.ManagedDefaultVolumeSnapshotClass).To(gstruct.PointTo(Equal(true)))
 		})
 	})
+
+	Describe("#SetDefaults_SpotConfig", func() {
+		It("should default the priority and the eviction policy", func() {
+			obj := &SpotConfig{}
+
+			SetDefaults_SpotConfig(obj)
+
+			Expect(obj.Priority).To(gstruct.PointTo(Equal(VMPrioritySpot)))
+			Expect(obj.EvictionPolicy).To(gstruct.PointTo(Equal(SpotEvictionPolicyDelete)))
+		})
+
+		It("should not overwrite the configured eviction policy", func() {
+			evictionPolicy := SpotEvictionPolicyDeallocate
+			obj := &SpotConfig{EvictionPolicy: &evictionPolicy}
+
+			SetDefaults_SpotConfig(obj)
+
+			Expect(obj.EvictionPolicy).To(gstruct.PointTo(Equal(SpotEvictionPolicyDeallocate)))
+		})
+	})
+
+	Describe("#SetDefaults_SecurityConfig", func() {
+		It("should default to trusted launch with secure boot and vTPM", func() {
+			obj := &SecurityConfig{}
+
+			SetDefaults_SecurityConfig(obj)
+
+			Expect(obj.Type).To(gstruct.PointTo(Equal(SecurityTypeTrustedLaunch)))
+			Expect(obj.SecureBoot).To(gstruct.PointTo(BeTrue()))
+			Expect(obj.VTPM).To(gstruct.PointTo(BeTrue()))
+			Expect(obj.OSDiskEncryptionType).To(BeNil())
+		})
+
+		It("should default the OS disk encryption type of confidential VMs", func() {
+			securityType := SecurityTypeConfidentialVM
+			obj := &SecurityConfig{Type: &securityType, SecureBoot: pointer.Bool(false)}
+
+			SetDefaults_SecurityConfig(obj)
+
+			Expect(obj.SecureBoot).To(gstruct.PointTo(BeFalse()))
+			Expect(obj.OSDiskEncryptionType).To(gstruct.PointTo(Equal(SecurityEncryptionTypeVMGuestStateOnly)))
+		})
+	})
+
+	Describe("#SetDefaults_VMExtension", func() {
+		It("should enable the automatic upgrade of minor versions", func() {
+			obj := &VMExtension{}
+
+			SetDefaults_VMExtension(obj)
+
+			Expect(obj.AutoUpgradeMinorVersion).To(gstruct.PointTo(BeTrue()))
+		})
+
+		It("should not overwrite the automatic upgrade of minor versions", func() {
+			obj := &VMExtension{AutoUpgradeMinorVersion: pointer.Bool(false)}
+
+			SetDefaults_VMExtension(obj)
+
+			Expect(obj.AutoUpgradeMinorVersion).To(gstruct.PointTo(BeFalse()))
+		})
+	})
 })
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -65,6 +66,58 @@ type MachineImageVersion struct {
 	// Architecture is the CPU architecture of the machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// SecurityTypes is the list of security types supported by the image. If empty, the security type is not restricted.
+	// +optional
+	SecurityTypes []SecurityType `json:"securityTypes,omitempty"`
+	// Regions contains region-specific image references which take precedence over the image reference of the version,
+	// e.g. for shared image galleries which are only replicated to some regions.
+	// +optional
+	Regions []RegionImage `json:"regions,omitempty"`
+	// Plan is the purchase plan of a marketplace image. It must be set for marketplace images which require one, e.g.
+	// vendor-licensed or hardened images, and is only allowed for images referenced by URN.
+	// +optional
+	Plan *MachineImagePlan `json:"plan,omitempty"`
+	// OperatingSystem is the operating system of the image. Defaults to linux.
+	// +optional
+	OperatingSystem *OperatingSystem `json:"operatingSystem,omitempty"`
+}
+
+// MachineImagePlan is the purchase plan of a marketplace image.
+type MachineImagePlan struct {
+	// Name is the name of the plan.
+	Name string `json:"name"`
+	// Product is the product of the image, i.e. the offer of its URN.
+	Product string `json:"product"`
+	// Publisher is the publisher of the image.
+	Publisher string `json:"publisher"`
+}
+
+// OperatingSystem is the operating system of a machine image.
+type OperatingSystem string
+
+const (
+	// OperatingSystemLinux is the operating system of Linux images.
+	OperatingSystemLinux OperatingSystem = "linux"
+	// OperatingSystemWindows is the operating system of Windows Server images.
+	OperatingSystemWindows OperatingSystem = "windows"
+)
+
+// RegionImage is the image reference of a machine image version in a region.
+type RegionImage struct {
+	// Name is the name of the region.
+	Name string `json:"name"`
+	// URN is the uniform resource name of the image, it has the format 'publisher:offer:sku:version'.
+	// +optional
+	URN *string `json:"urn,omitempty"`
+	// ID is the Shared Image Gallery image id.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// CommunityGalleryImageID is the Community Image Gallery image id, it has the format '/CommunityGalleries/myGallery/Images/myImage/Versions/myVersion'
+	// +optional
+	CommunityGalleryImageID *string `json:"communityGalleryImageID,omitempty"`
+	// SharedGalleryImageID is the Shared Image Gallery image id, it has the format '/SharedGalleries/sharedGalleryName/Images/sharedGalleryImageName/Versions/sharedGalleryImageVersionName'
+	// +optional
+	SharedGalleryImageID *string `json:"sharedGalleryImageID,omitempty"`
 }
 
 // MachineType contains provider specific information to a machine type.
@@ -74,4 +127,61 @@ type MachineType struct {
 	// AcceleratedNetworking is an indicator if the machine type supports Azure accelerated networking.
 	// +optional
 	AcceleratedNetworking *bool `json:"acceleratedNetworking,omitempty"`
+	// CacheDiskSizeGB is the size of the cache disk of the machine type in GiB. Ephemeral OS disks can only be placed
+	// on the cache disk if it is at least as large as the OS disk.
+	// +optional
+	CacheDiskSizeGB *int32 `json:"cacheDiskSizeGB,omitempty"`
+	// ResourceDiskSizeGB is the size of the resource (temp) disk of the machine type in GiB. Ephemeral OS disks can
+	// only be placed on the resource disk if it is at least as large as the OS disk.
+	// +optional
+	ResourceDiskSizeGB *int32 `json:"resourceDiskSizeGB,omitempty"`
+	// PremiumIO is an indicator if the machine type supports premium storage. If it is false, premium disk types
+	// cannot be used for the volumes of the machines.
+	// +optional
+	PremiumIO *bool `json:"premiumIO,omitempty"`
+	// UltraSSD is an indicator if the machine type supports ultra disks.
+	// +optional
+	UltraSSD *bool `json:"ultraSSD,omitempty"`
+	// MaxNetworkInterfaces is the maximum number of network interfaces which can be attached to machines of the
+	// machine type.
+	// +optional
+	MaxNetworkInterfaces *int32 `json:"maxNetworkInterfaces,omitempty"`
+	// EphemeralOSDisk is an indicator if the machine type supports ephemeral OS disks. Machine types are assumed to
+	// support them unless stated otherwise.
+	// +optional
+	EphemeralOSDisk *bool `json:"ephemeralOSDisk,omitempty"`
+	// MaxDataDisks is the maximum number of data disks which can be attached to machines of the machine type.
+	// +optional
+	MaxDataDisks *int32 `json:"maxDataDisks,omitempty"`
+	// Architectures are the CPU architectures supported by the machine type. If empty, the architecture is not restricted.
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+	// SecurityTypes are the security types supported by the machine type. If empty, the security type is not restricted.
+	// +optional
+	SecurityTypes []SecurityType `json:"securityTypes,omitempty"`
+	// Zones are the zones per region in which the machine type is available. Regions which are not listed are not
+	// restricted.
+	// +optional
+	Zones []MachineTypeZones `json:"zones,omitempty"`
+	// CPU is the number of vCPUs of the machine type.
+	// +optional
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Memory is the memory of the machine type.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// GPU is the number of GPUs of the machine type.
+	// +optional
+	GPU *resource.Quantity `json:"gpu,omitempty"`
+	// EphemeralStorage is the ephemeral storage of nodes of the machine type. It is only used for the node template of
+	// worker pools which neither configure the size of their volume nor a kubelet data volume.
+	// +optional
+	EphemeralStorage *resource.Quantity `json:"ephemeralStorage,omitempty"`
+}
+
+// MachineTypeZones contains the zones of a region in which a machine type is available.
+type MachineTypeZones struct {
+	// Region is the name of the region.
+	Region string `json:"region"`
+	// Names are the names of the zones.
+	Names []string `json:"names"`
 }
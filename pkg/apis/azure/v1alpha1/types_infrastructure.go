@@ -25,6 +25,37 @@ type InfrastructureConfig struct {
 	// Zoned indicates whether the cluster uses availability zones.
 	// +optional
 	Zoned bool `json:"zoned,omitempty"`
+	// ResourceLocks contains the configuration for management locks on the infrastructure resources.
+	// +optional
+	ResourceLocks *ResourceLocksConfig `json:"resourceLocks,omitempty"`
+	// DiskEncryption contains the default encryption settings for the disks of all worker pools.
+	// +optional
+	DiskEncryption *DiskEncryption `json:"diskEncryption,omitempty"`
+	// BootDiagnostics contains the default boot diagnostics settings of the machines of all worker pools.
+	// +optional
+	BootDiagnostics *BootDiagnostics `json:"bootDiagnostics,omitempty"`
+	// OrchestrationMode is the mode in which the machines of a non-zonal cluster are orchestrated. New clusters use
+	// VMSSFlex. Clusters which use an availability set can be migrated to VMSSFlex once all of their worker pools
+	// have been migrated.
+	// +optional
+	OrchestrationMode *OrchestrationMode `json:"orchestrationMode,omitempty"`
+}
+
+// OrchestrationMode is the mode in which the machines of a non-zonal cluster are orchestrated.
+type OrchestrationMode string
+
+const (
+	// OrchestrationModeAvailabilitySet places the machines of all worker pools in a shared availability set.
+	OrchestrationModeAvailabilitySet OrchestrationMode = "AvailabilitySet"
+	// OrchestrationModeVMSSFlex places the machines of each worker pool in a virtual machine scale set with flexible
+	// orchestration (VMO).
+	OrchestrationModeVMSSFlex OrchestrationMode = "VMSSFlex"
+)
+
+// ResourceLocksConfig contains the configuration for management locks on the infrastructure resources.
+type ResourceLocksConfig struct {
+	// Enabled indicates whether CanNotDelete locks are placed on the network resources created for the shoot.
+	Enabled bool `json:"enabled"`
 }
 
 // ResourceGroup is azure resource group
@@ -48,8 +79,119 @@ type NetworkConfig struct {
 	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
 	// Zones is a list of zones with their respective configuration.
 	Zones []Zone `json:"zones,omitempty"`
+	// ApplicationSecurityGroups is a list of application security groups which are created for the shoot. Worker pools
+	// can assign their machines to them and security rules can reference them.
+	// +optional
+	ApplicationSecurityGroups []ApplicationSecurityGroupConfig `json:"applicationSecurityGroups,omitempty"`
+	// SecurityRules is a list of additional rules for the network security group of the worker nodes.
+	// +optional
+	SecurityRules []SecurityRule `json:"securityRules,omitempty"`
+	// AdditionalSubnets is a list of subnets for the worker nodes in addition to the subnet of the workers or zones.
+	// Worker pools select them by name.
+	// +optional
+	AdditionalSubnets []AdditionalSubnet `json:"additionalSubnets,omitempty"`
+}
+
+// AdditionalSubnet contains the configuration of an additional subnet for the worker nodes. It is associated with the
+// route table, the security group and the NAT gateway of the nodes subnet of its zone.
+type AdditionalSubnet struct {
+	// Name is the name of the subnet, which is used by worker pools to select it.
+	Name string `json:"name"`
+	// CIDR is the CIDR range of the subnet.
+	CIDR string `json:"cidr"`
+	// Zone is the zone of the subnet. It is required if the cluster uses a subnet per zone and forbidden otherwise.
+	// +optional
+	Zone *int32 `json:"zone,omitempty"`
+	// ServiceEndpoints is a list of Azure ServiceEndpoints which should be associated with the subnet.
+	// +optional
+	ServiceEndpoints []string `json:"serviceEndpoints,omitempty"`
 }
 
+// ApplicationSecurityGroupConfig contains the configuration of an application security group which is created for the
+// shoot.
+type ApplicationSecurityGroupConfig struct {
+	// Name is the name of the application security group, which is used to reference it within the shoot.
+	Name string `json:"name"`
+}
+
+// ApplicationSecurityGroupReference references an application security group. Exactly one of the fields must be set.
+type ApplicationSecurityGroupReference struct {
+	// Name is the name of an application security group which is created for the shoot.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// ID is the id of an existing application security group.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// SecurityRule is a rule of the network security group of the worker nodes.
+type SecurityRule struct {
+	// Name is the name of the rule.
+	Name string `json:"name"`
+	// Priority is the priority of the rule. Rules with a lower value are evaluated first.
+	Priority int32 `json:"priority"`
+	// Direction is the direction of the traffic the rule applies to.
+	Direction SecurityRuleDirection `json:"direction"`
+	// Access determines whether the traffic is allowed or denied.
+	Access SecurityRuleAccess `json:"access"`
+	// Protocol is the network protocol the rule applies to.
+	Protocol SecurityRuleProtocol `json:"protocol"`
+	// Source is the source of the traffic.
+	// +optional
+	Source SecurityRuleEndpoint `json:"source,omitempty"`
+	// Destination is the destination of the traffic.
+	// +optional
+	Destination SecurityRuleEndpoint `json:"destination,omitempty"`
+}
+
+// SecurityRuleEndpoint is the source or destination of a security rule. Address prefixes and application security
+// groups are mutually exclusive. If neither of them is set, the rule applies to any address.
+type SecurityRuleEndpoint struct {
+	// AddressPrefixes is a list of CIDRs or service tags.
+	// +optional
+	AddressPrefixes []string `json:"addressPrefixes,omitempty"`
+	// ApplicationSecurityGroups is a list of application security groups.
+	// +optional
+	ApplicationSecurityGroups []ApplicationSecurityGroupReference `json:"applicationSecurityGroups,omitempty"`
+	// PortRanges is a list of ports or port ranges. If it is not set, the rule applies to any port.
+	// +optional
+	PortRanges []string `json:"portRanges,omitempty"`
+}
+
+// SecurityRuleDirection is the direction of the traffic a security rule applies to.
+type SecurityRuleDirection string
+
+const (
+	// SecurityRuleDirectionInbound is the direction of incoming traffic.
+	SecurityRuleDirectionInbound SecurityRuleDirection = "Inbound"
+	// SecurityRuleDirectionOutbound is the direction of outgoing traffic.
+	SecurityRuleDirectionOutbound SecurityRuleDirection = "Outbound"
+)
+
+// SecurityRuleAccess determines whether a security rule allows or denies traffic.
+type SecurityRuleAccess string
+
+const (
+	// SecurityRuleAccessAllow allows the traffic.
+	SecurityRuleAccessAllow SecurityRuleAccess = "Allow"
+	// SecurityRuleAccessDeny denies the traffic.
+	SecurityRuleAccessDeny SecurityRuleAccess = "Deny"
+)
+
+// SecurityRuleProtocol is the network protocol a security rule applies to.
+type SecurityRuleProtocol string
+
+const (
+	// SecurityRuleProtocolTCP is the TCP protocol.
+	SecurityRuleProtocolTCP SecurityRuleProtocol = "Tcp"
+	// SecurityRuleProtocolUDP is the UDP protocol.
+	SecurityRuleProtocolUDP SecurityRuleProtocol = "Udp"
+	// SecurityRuleProtocolICMP is the ICMP protocol.
+	SecurityRuleProtocolICMP SecurityRuleProtocol = "Icmp"
+	// SecurityRuleProtocolAny matches any protocol.
+	SecurityRuleProtocolAny SecurityRuleProtocol = "*"
+)
+
 // NatGatewayConfig contains configuration for the NAT gateway and the attached resources.
 type NatGatewayConfig struct {
 	// Enabled is an indicator if NAT gateway should be deployed.
@@ -124,6 +266,9 @@ type InfrastructureStatus struct {
 	RouteTables []RouteTable `json:"routeTables"`
 	// SecurityGroups is a list of created security groups
 	SecurityGroups []SecurityGroup `json:"securityGroups"`
+	// ApplicationSecurityGroups is a list of created application security groups.
+	// +optional
+	ApplicationSecurityGroups []ApplicationSecurityGroup `json:"applicationSecurityGroups,omitempty"`
 	// Identity is the status of the managed identity.
 	// +optional
 	Identity *IdentityStatus `json:"identity,omitempty"`
@@ -152,6 +297,8 @@ const (
 	PurposeNodes Purpose = "nodes"
 	// PurposeInternal is a Purpose for internal use.
 	PurposeInternal Purpose = "internal"
+	// PurposeAdditionalNodes is a Purpose for additional subnets of nodes, which are only used by selected worker pools.
+	PurposeAdditionalNodes Purpose = "additionalNodes"
 )
 
 // NetworkLayout is the network layout type for the cluster.
@@ -177,6 +324,10 @@ type Subnet struct {
 	// Migrated is set when the network layout is migrated from NetworkLayoutSingleSubnet to NetworkLayoutMultipleSubnet.
 	// Only the subnet that was used prior to the migration should have this attribute set.
 	Migrated bool `json:"migrated,omitempty"`
+	// AdditionalSubnetName is the name of the subnet in the list of additional subnets. It is only set for subnets with
+	// the PurposeAdditionalNodes purpose.
+	// +optional
+	AdditionalSubnetName *string `json:"additionalSubnetName,omitempty"`
 }
 
 // AvailabilitySet contains information about the azure availability set
@@ -211,6 +362,14 @@ type SecurityGroup struct {
 	Name string `json:"name"`
 }
 
+// ApplicationSecurityGroup contains information about an application security group created for the shoot.
+type ApplicationSecurityGroup struct {
+	// Name is the name of the application security group within the shoot.
+	Name string `json:"name"`
+	// ID is the id of the application security group.
+	ID string `json:"id"`
+}
+
 // VNet contains information about the VNet and some related resources.
 type VNet struct {
 	// Name is the name of an existing vNet which should be used.
@@ -239,12 +398,30 @@ type VNetStatus struct {
 // IdentityConfig contains configuration for the managed identity.
 type IdentityConfig struct {
 	// Name is the name of the identity.
-	Name string `json:"name"`
+	// +optional
+	Name string `json:"name,omitempty"`
 	// ResourceGroup is the resource group where the identity belongs to.
-	ResourceGroup string `json:"resourceGroup"`
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
 	// ACRAccess indicated if the identity should be used by the Shoot worker nodes to pull from an Azure Container Registry.
 	// +optional
 	ACRAccess *bool `json:"acrAccess,omitempty"`
+	// Managed indicates whether the identity is created and managed by Gardener. A managed identity is created in the
+	// shoot's resource group and deleted together with the shoot. Name and ResourceGroup must not be set in this case.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
+	// RoleAssignments are the roles which are assigned to the managed identity.
+	// +optional
+	RoleAssignments []IdentityRoleAssignment `json:"roleAssignments,omitempty"`
+}
+
+// IdentityRoleAssignment describes a role which is assigned to the managed identity on a scope.
+type IdentityRoleAssignment struct {
+	// RoleDefinitionID is the ID of the role definition. It is either the fully qualified ID or only the GUID of the
+	// role definition, e.g. "7f951dda-4ed3-4680-a7ca-43fe172d538d" for AcrPull.
+	RoleDefinitionID string `json:"roleDefinitionID"`
+	// Scope is the ID of the subscription, resource group or resource the role is assigned on.
+	Scope string `json:"scope"`
 }
 
 // IdentityStatus contains the status information of the created managed identity.
@@ -262,6 +439,9 @@ type IdentityStatus struct {
 // InfrastructureState contains state information of the infrastructure resource.
 type InfrastructureState struct {
 	metav1.TypeMeta `json:",inline"`
+	// Version is the schema version of the state. It is empty for states written before the schema was versioned.
+	// +optional
+	Version string `json:"version,omitempty"`
 	// Data is map to store things.
 	// +optional
 	Data map[string]string `json:"data,omitempty"`
@@ -26,6 +26,7 @@ import (
 
 	azure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -37,6 +38,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AdditionalSubnet)(nil), (*azure.AdditionalSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(a.(*AdditionalSubnet), b.(*azure.AdditionalSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.AdditionalSubnet)(nil), (*AdditionalSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(a.(*azure.AdditionalSubnet), b.(*AdditionalSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApplicationSecurityGroup)(nil), (*azure.ApplicationSecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApplicationSecurityGroup_To_azure_ApplicationSecurityGroup(a.(*ApplicationSecurityGroup), b.(*azure.ApplicationSecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ApplicationSecurityGroup)(nil), (*ApplicationSecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ApplicationSecurityGroup_To_v1alpha1_ApplicationSecurityGroup(a.(*azure.ApplicationSecurityGroup), b.(*ApplicationSecurityGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApplicationSecurityGroupConfig)(nil), (*azure.ApplicationSecurityGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApplicationSecurityGroupConfig_To_azure_ApplicationSecurityGroupConfig(a.(*ApplicationSecurityGroupConfig), b.(*azure.ApplicationSecurityGroupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ApplicationSecurityGroupConfig)(nil), (*ApplicationSecurityGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ApplicationSecurityGroupConfig_To_v1alpha1_ApplicationSecurityGroupConfig(a.(*azure.ApplicationSecurityGroupConfig), b.(*ApplicationSecurityGroupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApplicationSecurityGroupReference)(nil), (*azure.ApplicationSecurityGroupReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApplicationSecurityGroupReference_To_azure_ApplicationSecurityGroupReference(a.(*ApplicationSecurityGroupReference), b.(*azure.ApplicationSecurityGroupReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ApplicationSecurityGroupReference)(nil), (*ApplicationSecurityGroupReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ApplicationSecurityGroupReference_To_v1alpha1_ApplicationSecurityGroupReference(a.(*azure.ApplicationSecurityGroupReference), b.(*ApplicationSecurityGroupReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilitySet)(nil), (*azure.AvailabilitySet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AvailabilitySet_To_azure_AvailabilitySet(a.(*AvailabilitySet), b.(*azure.AvailabilitySet), scope)
 	}); err != nil {
@@ -57,6 +98,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BootDiagnostics)(nil), (*azure.BootDiagnostics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BootDiagnostics_To_azure_BootDiagnostics(a.(*BootDiagnostics), b.(*azure.BootDiagnostics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.BootDiagnostics)(nil), (*BootDiagnostics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_BootDiagnostics_To_v1alpha1_BootDiagnostics(a.(*azure.BootDiagnostics), b.(*BootDiagnostics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CapacityReservationConfig)(nil), (*azure.CapacityReservationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CapacityReservationConfig_To_azure_CapacityReservationConfig(a.(*CapacityReservationConfig), b.(*azure.CapacityReservationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.CapacityReservationConfig)(nil), (*CapacityReservationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_CapacityReservationConfig_To_v1alpha1_CapacityReservationConfig(a.(*azure.CapacityReservationConfig), b.(*CapacityReservationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*azure.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*azure.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -87,6 +148,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*azure.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DataVolume_To_azure_DataVolume(a.(*DataVolume), b.(*azure.DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DataVolume)(nil), (*DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DataVolume_To_v1alpha1_DataVolume(a.(*azure.DataVolume), b.(*DataVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DedicatedHostConfig)(nil), (*azure.DedicatedHostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DedicatedHostConfig_To_azure_DedicatedHostConfig(a.(*DedicatedHostConfig), b.(*azure.DedicatedHostConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DedicatedHostConfig)(nil), (*DedicatedHostConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DedicatedHostConfig_To_v1alpha1_DedicatedHostConfig(a.(*azure.DedicatedHostConfig), b.(*DedicatedHostConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskEncryption)(nil), (*azure.DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiskEncryption_To_azure_DiskEncryption(a.(*DiskEncryption), b.(*azure.DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.DiskEncryption)(nil), (*DiskEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_DiskEncryption_To_v1alpha1_DiskEncryption(a.(*azure.DiskEncryption), b.(*DiskEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DomainCount)(nil), (*azure.DomainCount)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DomainCount_To_azure_DomainCount(a.(*DomainCount), b.(*azure.DomainCount), scope)
 	}); err != nil {
@@ -107,6 +198,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*IdentityRoleAssignment)(nil), (*azure.IdentityRoleAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(a.(*IdentityRoleAssignment), b.(*azure.IdentityRoleAssignment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.IdentityRoleAssignment)(nil), (*IdentityRoleAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(a.(*azure.IdentityRoleAssignment), b.(*IdentityRoleAssignment), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IdentityStatus)(nil), (*azure.IdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(a.(*IdentityStatus), b.(*azure.IdentityStatus), scope)
 	}); err != nil {
@@ -157,6 +258,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImagePlan)(nil), (*azure.MachineImagePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImagePlan_To_azure_MachineImagePlan(a.(*MachineImagePlan), b.(*azure.MachineImagePlan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.MachineImagePlan)(nil), (*MachineImagePlan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_MachineImagePlan_To_v1alpha1_MachineImagePlan(a.(*azure.MachineImagePlan), b.(*MachineImagePlan), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImageVersion)(nil), (*azure.MachineImageVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(a.(*MachineImageVersion), b.(*azure.MachineImageVersion), scope)
 	}); err != nil {
@@ -187,6 +298,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineTypeZones)(nil), (*azure.MachineTypeZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineTypeZones_To_azure_MachineTypeZones(a.(*MachineTypeZones), b.(*azure.MachineTypeZones), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.MachineTypeZones)(nil), (*MachineTypeZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_MachineTypeZones_To_v1alpha1_MachineTypeZones(a.(*azure.MachineTypeZones), b.(*MachineTypeZones), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NatGatewayConfig)(nil), (*azure.NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(a.(*NatGatewayConfig), b.(*azure.NatGatewayConfig), scope)
 	}); err != nil {
@@ -207,6 +328,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterface)(nil), (*azure.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterface_To_azure_NetworkInterface(a.(*NetworkInterface), b.(*azure.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_NetworkInterface_To_v1alpha1_NetworkInterface(a.(*azure.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*azure.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(a.(*NetworkStatus), b.(*azure.NetworkStatus), scope)
 	}); err != nil {
@@ -217,6 +348,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OSDiskConfig)(nil), (*azure.OSDiskConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OSDiskConfig_To_azure_OSDiskConfig(a.(*OSDiskConfig), b.(*azure.OSDiskConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.OSDiskConfig)(nil), (*OSDiskConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_OSDiskConfig_To_v1alpha1_OSDiskConfig(a.(*azure.OSDiskConfig), b.(*OSDiskConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProximityPlacementGroup)(nil), (*azure.ProximityPlacementGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProximityPlacementGroup_To_azure_ProximityPlacementGroup(a.(*ProximityPlacementGroup), b.(*azure.ProximityPlacementGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ProximityPlacementGroup)(nil), (*ProximityPlacementGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ProximityPlacementGroup_To_v1alpha1_ProximityPlacementGroup(a.(*azure.ProximityPlacementGroup), b.(*ProximityPlacementGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProximityPlacementGroupConfig)(nil), (*azure.ProximityPlacementGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProximityPlacementGroupConfig_To_azure_ProximityPlacementGroupConfig(a.(*ProximityPlacementGroupConfig), b.(*azure.ProximityPlacementGroupConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ProximityPlacementGroupConfig)(nil), (*ProximityPlacementGroupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ProximityPlacementGroupConfig_To_v1alpha1_ProximityPlacementGroupConfig(a.(*azure.ProximityPlacementGroupConfig), b.(*ProximityPlacementGroupConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PublicIPReference)(nil), (*azure.PublicIPReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(a.(*PublicIPReference), b.(*azure.PublicIPReference), scope)
 	}); err != nil {
@@ -227,6 +388,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RegionImage)(nil), (*azure.RegionImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegionImage_To_azure_RegionImage(a.(*RegionImage), b.(*azure.RegionImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.RegionImage)(nil), (*RegionImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_RegionImage_To_v1alpha1_RegionImage(a.(*azure.RegionImage), b.(*RegionImage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceGroup)(nil), (*azure.ResourceGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(a.(*ResourceGroup), b.(*azure.ResourceGroup), scope)
 	}); err != nil {
@@ -237,6 +408,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResourceLocksConfig)(nil), (*azure.ResourceLocksConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResourceLocksConfig_To_azure_ResourceLocksConfig(a.(*ResourceLocksConfig), b.(*azure.ResourceLocksConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.ResourceLocksConfig)(nil), (*ResourceLocksConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_ResourceLocksConfig_To_v1alpha1_ResourceLocksConfig(a.(*azure.ResourceLocksConfig), b.(*ResourceLocksConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RouteTable)(nil), (*azure.RouteTable)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RouteTable_To_azure_RouteTable(a.(*RouteTable), b.(*azure.RouteTable), scope)
 	}); err != nil {
@@ -247,6 +428,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecurityConfig)(nil), (*azure.SecurityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityConfig_To_azure_SecurityConfig(a.(*SecurityConfig), b.(*azure.SecurityConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecurityConfig)(nil), (*SecurityConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecurityConfig_To_v1alpha1_SecurityConfig(a.(*azure.SecurityConfig), b.(*SecurityConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecurityGroup)(nil), (*azure.SecurityGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(a.(*SecurityGroup), b.(*azure.SecurityGroup), scope)
 	}); err != nil {
@@ -257,6 +448,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SecurityRule)(nil), (*azure.SecurityRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityRule_To_azure_SecurityRule(a.(*SecurityRule), b.(*azure.SecurityRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecurityRule)(nil), (*SecurityRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecurityRule_To_v1alpha1_SecurityRule(a.(*azure.SecurityRule), b.(*SecurityRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecurityRuleEndpoint)(nil), (*azure.SecurityRuleEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(a.(*SecurityRuleEndpoint), b.(*azure.SecurityRuleEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SecurityRuleEndpoint)(nil), (*SecurityRuleEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(a.(*azure.SecurityRuleEndpoint), b.(*SecurityRuleEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SpotConfig)(nil), (*azure.SpotConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SpotConfig_To_azure_SpotConfig(a.(*SpotConfig), b.(*azure.SpotConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.SpotConfig)(nil), (*SpotConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_SpotConfig_To_v1alpha1_SpotConfig(a.(*azure.SpotConfig), b.(*SpotConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*azure.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Storage_To_azure_Storage(a.(*Storage), b.(*azure.Storage), scope)
 	}); err != nil {
@@ -267,6 +488,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StuckMachine)(nil), (*azure.StuckMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StuckMachine_To_azure_StuckMachine(a.(*StuckMachine), b.(*azure.StuckMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.StuckMachine)(nil), (*StuckMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_StuckMachine_To_v1alpha1_StuckMachine(a.(*azure.StuckMachine), b.(*StuckMachine), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Subnet)(nil), (*azure.Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Subnet_To_azure_Subnet(a.(*Subnet), b.(*azure.Subnet), scope)
 	}); err != nil {
@@ -277,6 +508,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VMExtension)(nil), (*azure.VMExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMExtension_To_azure_VMExtension(a.(*VMExtension), b.(*azure.VMExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*azure.VMExtension)(nil), (*VMExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_azure_VMExtension_To_v1alpha1_VMExtension(a.(*azure.VMExtension), b.(*VMExtension), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VNet)(nil), (*azure.VNet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VNet_To_azure_VNet(a.(*VNet), b.(*azure.VNet), scope)
 	}); err != nil {
@@ -360,6 +601,96 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in *AdditionalSubnet, out *azure.AdditionalSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	return nil
+}
+
+// Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet is an autogenerated conversion function.
+func Convert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in *AdditionalSubnet, out *azure.AdditionalSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AdditionalSubnet_To_azure_AdditionalSubnet(in, out, s)
+}
+
+func autoConvert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in *azure.AdditionalSubnet, out *AdditionalSubnet, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CIDR = in.CIDR
+	out.Zone = (*int32)(unsafe.Pointer(in.Zone))
+	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
+	return nil
+}
+
+// Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet is an autogenerated conversion function.
+func Convert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in *azure.AdditionalSubnet, out *AdditionalSubnet, s conversion.Scope) error {
+	return autoConvert_azure_AdditionalSubnet_To_v1alpha1_AdditionalSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApplicationSecurityGroup_To_azure_ApplicationSecurityGroup(in *ApplicationSecurityGroup, out *azure.ApplicationSecurityGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_ApplicationSecurityGroup_To_azure_ApplicationSecurityGroup is an autogenerated conversion function.
+func Convert_v1alpha1_ApplicationSecurityGroup_To_azure_ApplicationSecurityGroup(in *ApplicationSecurityGroup, out *azure.ApplicationSecurityGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApplicationSecurityGroup_To_azure_ApplicationSecurityGroup(in, out, s)
+}
+
+func autoConvert_azure_ApplicationSecurityGroup_To_v1alpha1_ApplicationSecurityGroup(in *azure.ApplicationSecurityGroup, out *ApplicationSecurityGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_azure_ApplicationSecurityGroup_To_v1alpha1_ApplicationSecurityGroup is an autogenerated conversion function.
+func Convert_azure_ApplicationSecurityGroup_To_v1alpha1_ApplicationSecurityGroup(in *azure.ApplicationSecurityGroup, out *ApplicationSecurityGroup, s conversion.Scope) error {
+	return autoConvert_azure_ApplicationSecurityGroup_To_v1alpha1_ApplicationSecurityGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApplicationSecurityGroupConfig_To_azure_ApplicationSecurityGroupConfig(in *ApplicationSecurityGroupConfig, out *azure.ApplicationSecurityGroupConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ApplicationSecurityGroupConfig_To_azure_ApplicationSecurityGroupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ApplicationSecurityGroupConfig_To_azure_ApplicationSecurityGroupConfig(in *ApplicationSecurityGroupConfig, out *azure.ApplicationSecurityGroupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApplicationSecurityGroupConfig_To_azure_ApplicationSecurityGroupConfig(in, out, s)
+}
+
+func autoConvert_azure_ApplicationSecurityGroupConfig_To_v1alpha1_ApplicationSecurityGroupConfig(in *azure.ApplicationSecurityGroupConfig, out *ApplicationSecurityGroupConfig, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_azure_ApplicationSecurityGroupConfig_To_v1alpha1_ApplicationSecurityGroupConfig is an autogenerated conversion function.
+func Convert_azure_ApplicationSecurityGroupConfig_To_v1alpha1_ApplicationSecurityGroupConfig(in *azure.ApplicationSecurityGroupConfig, out *ApplicationSecurityGroupConfig, s conversion.Scope) error {
+	return autoConvert_azure_ApplicationSecurityGroupConfig_To_v1alpha1_ApplicationSecurityGroupConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApplicationSecurityGroupReference_To_azure_ApplicationSecurityGroupReference(in *ApplicationSecurityGroupReference, out *azure.ApplicationSecurityGroupReference, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	return nil
+}
+
+// Convert_v1alpha1_ApplicationSecurityGroupReference_To_azure_ApplicationSecurityGroupReference is an autogenerated conversion function.
+func Convert_v1alpha1_ApplicationSecurityGroupReference_To_azure_ApplicationSecurityGroupReference(in *ApplicationSecurityGroupReference, out *azure.ApplicationSecurityGroupReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApplicationSecurityGroupReference_To_azure_ApplicationSecurityGroupReference(in, out, s)
+}
+
+func autoConvert_azure_ApplicationSecurityGroupReference_To_v1alpha1_ApplicationSecurityGroupReference(in *azure.ApplicationSecurityGroupReference, out *ApplicationSecurityGroupReference, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	return nil
+}
+
+// Convert_azure_ApplicationSecurityGroupReference_To_v1alpha1_ApplicationSecurityGroupReference is an autogenerated conversion function.
+func Convert_azure_ApplicationSecurityGroupReference_To_v1alpha1_ApplicationSecurityGroupReference(in *azure.ApplicationSecurityGroupReference, out *ApplicationSecurityGroupReference, s conversion.Scope) error {
+	return autoConvert_azure_ApplicationSecurityGroupReference_To_v1alpha1_ApplicationSecurityGroupReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_AvailabilitySet_To_azure_AvailabilitySet(in *AvailabilitySet, out *azure.AvailabilitySet, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.ID = in.ID
@@ -410,6 +741,48 @@ func Convert_azure_AzureResource_To_v1alpha1_AzureResource(in *azure.AzureResour
 	return autoConvert_azure_AzureResource_To_v1alpha1_AzureResource(in, out, s)
 }
 
+func autoConvert_v1alpha1_BootDiagnostics_To_azure_BootDiagnostics(in *BootDiagnostics, out *azure.BootDiagnostics, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	return nil
+}
+
+// Convert_v1alpha1_BootDiagnostics_To_azure_BootDiagnostics is an autogenerated conversion function.
+func Convert_v1alpha1_BootDiagnostics_To_azure_BootDiagnostics(in *BootDiagnostics, out *azure.BootDiagnostics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BootDiagnostics_To_azure_BootDiagnostics(in, out, s)
+}
+
+func autoConvert_azure_BootDiagnostics_To_v1alpha1_BootDiagnostics(in *azure.BootDiagnostics, out *BootDiagnostics, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.StorageURI = (*string)(unsafe.Pointer(in.StorageURI))
+	return nil
+}
+
+// Convert_azure_BootDiagnostics_To_v1alpha1_BootDiagnostics is an autogenerated conversion function.
+func Convert_azure_BootDiagnostics_To_v1alpha1_BootDiagnostics(in *azure.BootDiagnostics, out *BootDiagnostics, s conversion.Scope) error {
+	return autoConvert_azure_BootDiagnostics_To_v1alpha1_BootDiagnostics(in, out, s)
+}
+
+func autoConvert_v1alpha1_CapacityReservationConfig_To_azure_CapacityReservationConfig(in *CapacityReservationConfig, out *azure.CapacityReservationConfig, s conversion.Scope) error {
+	out.CapacityReservationGroupID = in.CapacityReservationGroupID
+	return nil
+}
+
+// Convert_v1alpha1_CapacityReservationConfig_To_azure_CapacityReservationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_CapacityReservationConfig_To_azure_CapacityReservationConfig(in *CapacityReservationConfig, out *azure.CapacityReservationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CapacityReservationConfig_To_azure_CapacityReservationConfig(in, out, s)
+}
+
+func autoConvert_azure_CapacityReservationConfig_To_v1alpha1_CapacityReservationConfig(in *azure.CapacityReservationConfig, out *CapacityReservationConfig, s conversion.Scope) error {
+	out.CapacityReservationGroupID = in.CapacityReservationGroupID
+	return nil
+}
+
+// Convert_azure_CapacityReservationConfig_To_v1alpha1_CapacityReservationConfig is an autogenerated conversion function.
+func Convert_azure_CapacityReservationConfig_To_v1alpha1_CapacityReservationConfig(in *azure.CapacityReservationConfig, out *CapacityReservationConfig, s conversion.Scope) error {
+	return autoConvert_azure_CapacityReservationConfig_To_v1alpha1_CapacityReservationConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_azure_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *azure.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -478,6 +851,76 @@ func Convert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *azure.C
 	return autoConvert_azure_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Caching = (*azure.CachingType)(unsafe.Pointer(in.Caching))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.ThroughputMBps = (*int64)(unsafe.Pointer(in.ThroughputMBps))
+	return nil
+}
+
+// Convert_v1alpha1_DataVolume_To_azure_DataVolume is an autogenerated conversion function.
+func Convert_v1alpha1_DataVolume_To_azure_DataVolume(in *DataVolume, out *azure.DataVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DataVolume_To_azure_DataVolume(in, out, s)
+}
+
+func autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Caching = (*CachingType)(unsafe.Pointer(in.Caching))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.ThroughputMBps = (*int64)(unsafe.Pointer(in.ThroughputMBps))
+	return nil
+}
+
+// Convert_azure_DataVolume_To_v1alpha1_DataVolume is an autogenerated conversion function.
+func Convert_azure_DataVolume_To_v1alpha1_DataVolume(in *azure.DataVolume, out *DataVolume, s conversion.Scope) error {
+	return autoConvert_azure_DataVolume_To_v1alpha1_DataVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_DedicatedHostConfig_To_azure_DedicatedHostConfig(in *DedicatedHostConfig, out *azure.DedicatedHostConfig, s conversion.Scope) error {
+	out.HostGroupID = in.HostGroupID
+	out.HostID = (*string)(unsafe.Pointer(in.HostID))
+	return nil
+}
+
+// Convert_v1alpha1_DedicatedHostConfig_To_azure_DedicatedHostConfig is an autogenerated conversion function.
+func Convert_v1alpha1_DedicatedHostConfig_To_azure_DedicatedHostConfig(in *DedicatedHostConfig, out *azure.DedicatedHostConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DedicatedHostConfig_To_azure_DedicatedHostConfig(in, out, s)
+}
+
+func autoConvert_azure_DedicatedHostConfig_To_v1alpha1_DedicatedHostConfig(in *azure.DedicatedHostConfig, out *DedicatedHostConfig, s conversion.Scope) error {
+	out.HostGroupID = in.HostGroupID
+	out.HostID = (*string)(unsafe.Pointer(in.HostID))
+	return nil
+}
+
+// Convert_azure_DedicatedHostConfig_To_v1alpha1_DedicatedHostConfig is an autogenerated conversion function.
+func Convert_azure_DedicatedHostConfig_To_v1alpha1_DedicatedHostConfig(in *azure.DedicatedHostConfig, out *DedicatedHostConfig, s conversion.Scope) error {
+	return autoConvert_azure_DedicatedHostConfig_To_v1alpha1_DedicatedHostConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_DiskEncryption_To_azure_DiskEncryption(in *DiskEncryption, out *azure.DiskEncryption, s conversion.Scope) error {
+	out.DiskEncryptionSetID = (*string)(unsafe.Pointer(in.DiskEncryptionSetID))
+	out.EncryptionAtHost = (*bool)(unsafe.Pointer(in.EncryptionAtHost))
+	return nil
+}
+
+// Convert_v1alpha1_DiskEncryption_To_azure_DiskEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_DiskEncryption_To_azure_DiskEncryption(in *DiskEncryption, out *azure.DiskEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiskEncryption_To_azure_DiskEncryption(in, out, s)
+}
+
+func autoConvert_azure_DiskEncryption_To_v1alpha1_DiskEncryption(in *azure.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	out.DiskEncryptionSetID = (*string)(unsafe.Pointer(in.DiskEncryptionSetID))
+	out.EncryptionAtHost = (*bool)(unsafe.Pointer(in.EncryptionAtHost))
+	return nil
+}
+
+// Convert_azure_DiskEncryption_To_v1alpha1_DiskEncryption is an autogenerated conversion function.
+func Convert_azure_DiskEncryption_To_v1alpha1_DiskEncryption(in *azure.DiskEncryption, out *DiskEncryption, s conversion.Scope) error {
+	return autoConvert_azure_DiskEncryption_To_v1alpha1_DiskEncryption(in, out, s)
+}
+
 func autoConvert_v1alpha1_DomainCount_To_azure_DomainCount(in *DomainCount, out *azure.DomainCount, s conversion.Scope) error {
 	out.Region = in.Region
 	out.Count = in.Count
@@ -504,6 +947,8 @@ func autoConvert_v1alpha1_IdentityConfig_To_azure_IdentityConfig(in *IdentityCon
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
 	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.Managed = in.Managed
+	out.RoleAssignments = *(*[]azure.IdentityRoleAssignment)(unsafe.Pointer(&in.RoleAssignments))
 	return nil
 }
 
@@ -516,6 +961,8 @@ func autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.Ident
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
 	out.ACRAccess = (*bool)(unsafe.Pointer(in.ACRAccess))
+	out.Managed = in.Managed
+	out.RoleAssignments = *(*[]IdentityRoleAssignment)(unsafe.Pointer(&in.RoleAssignments))
 	return nil
 }
 
@@ -524,6 +971,28 @@ func Convert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in *azure.IdentityC
 	return autoConvert_azure_IdentityConfig_To_v1alpha1_IdentityConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in *IdentityRoleAssignment, out *azure.IdentityRoleAssignment, s conversion.Scope) error {
+	out.RoleDefinitionID = in.RoleDefinitionID
+	out.Scope = in.Scope
+	return nil
+}
+
+// Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment is an autogenerated conversion function.
+func Convert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in *IdentityRoleAssignment, out *azure.IdentityRoleAssignment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IdentityRoleAssignment_To_azure_IdentityRoleAssignment(in, out, s)
+}
+
+func autoConvert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in *azure.IdentityRoleAssignment, out *IdentityRoleAssignment, s conversion.Scope) error {
+	out.RoleDefinitionID = in.RoleDefinitionID
+	out.Scope = in.Scope
+	return nil
+}
+
+// Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment is an autogenerated conversion function.
+func Convert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in *azure.IdentityRoleAssignment, out *IdentityRoleAssignment, s conversion.Scope) error {
+	return autoConvert_azure_IdentityRoleAssignment_To_v1alpha1_IdentityRoleAssignment(in, out, s)
+}
+
 func autoConvert_v1alpha1_IdentityStatus_To_azure_IdentityStatus(in *IdentityStatus, out *azure.IdentityStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.ClientID = in.ClientID
@@ -555,6 +1024,10 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_azure_InfrastructureConfig(in
 	}
 	out.Identity = (*azure.IdentityConfig)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.ResourceLocks = (*azure.ResourceLocksConfig)(unsafe.Pointer(in.ResourceLocks))
+	out.DiskEncryption = (*azure.DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
+	out.BootDiagnostics = (*azure.BootDiagnostics)(unsafe.Pointer(in.BootDiagnostics))
+	out.OrchestrationMode = (*azure.OrchestrationMode)(unsafe.Pointer(in.OrchestrationMode))
 	return nil
 }
 
@@ -570,6 +1043,10 @@ func autoConvert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in
 	}
 	out.Identity = (*IdentityConfig)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
+	out.ResourceLocks = (*ResourceLocksConfig)(unsafe.Pointer(in.ResourceLocks))
+	out.DiskEncryption = (*DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
+	out.BootDiagnostics = (*BootDiagnostics)(unsafe.Pointer(in.BootDiagnostics))
+	out.OrchestrationMode = (*OrchestrationMode)(unsafe.Pointer(in.OrchestrationMode))
 	return nil
 }
 
@@ -579,6 +1056,7 @@ func Convert_azure_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(in *azu
 }
 
 func autoConvert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *InfrastructureState, out *azure.InfrastructureState, s conversion.Scope) error {
+	out.Version = in.Version
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
 	out.ManagedItems = *(*[]azure.AzureResource)(unsafe.Pointer(&in.ManagedItems))
 	return nil
@@ -590,6 +1068,7 @@ func Convert_v1alpha1_InfrastructureState_To_azure_InfrastructureState(in *Infra
 }
 
 func autoConvert_azure_InfrastructureState_To_v1alpha1_InfrastructureState(in *azure.InfrastructureState, out *InfrastructureState, s conversion.Scope) error {
+	out.Version = in.Version
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
 	out.ManagedItems = *(*[]AzureResource)(unsafe.Pointer(&in.ManagedItems))
 	return nil
@@ -610,6 +1089,7 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_azure_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]azure.AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]azure.RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]azure.SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.ApplicationSecurityGroups = *(*[]azure.ApplicationSecurityGroup)(unsafe.Pointer(&in.ApplicationSecurityGroups))
 	out.Identity = (*azure.IdentityStatus)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
 	return nil
@@ -630,6 +1110,7 @@ func autoConvert_azure_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	out.AvailabilitySets = *(*[]AvailabilitySet)(unsafe.Pointer(&in.AvailabilitySets))
 	out.RouteTables = *(*[]RouteTable)(unsafe.Pointer(&in.RouteTables))
 	out.SecurityGroups = *(*[]SecurityGroup)(unsafe.Pointer(&in.SecurityGroups))
+	out.ApplicationSecurityGroups = *(*[]ApplicationSecurityGroup)(unsafe.Pointer(&in.ApplicationSecurityGroups))
 	out.Identity = (*IdentityStatus)(unsafe.Pointer(in.Identity))
 	out.Zoned = in.Zoned
 	return nil
@@ -649,6 +1130,8 @@ func autoConvert_v1alpha1_MachineImage_To_azure_MachineImage(in *MachineImage, o
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Plan = (*azure.MachineImagePlan)(unsafe.Pointer(in.Plan))
+	out.OperatingSystem = (*azure.OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
 	return nil
 }
 
@@ -666,6 +1149,8 @@ func autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineIm
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.Plan = (*MachineImagePlan)(unsafe.Pointer(in.Plan))
+	out.OperatingSystem = (*OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
 	return nil
 }
 
@@ -674,6 +1159,30 @@ func Convert_azure_MachineImage_To_v1alpha1_MachineImage(in *azure.MachineImage,
 	return autoConvert_azure_MachineImage_To_v1alpha1_MachineImage(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineImagePlan_To_azure_MachineImagePlan(in *MachineImagePlan, out *azure.MachineImagePlan, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Product = in.Product
+	out.Publisher = in.Publisher
+	return nil
+}
+
+// Convert_v1alpha1_MachineImagePlan_To_azure_MachineImagePlan is an autogenerated conversion function.
+func Convert_v1alpha1_MachineImagePlan_To_azure_MachineImagePlan(in *MachineImagePlan, out *azure.MachineImagePlan, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImagePlan_To_azure_MachineImagePlan(in, out, s)
+}
+
+func autoConvert_azure_MachineImagePlan_To_v1alpha1_MachineImagePlan(in *azure.MachineImagePlan, out *MachineImagePlan, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Product = in.Product
+	out.Publisher = in.Publisher
+	return nil
+}
+
+// Convert_azure_MachineImagePlan_To_v1alpha1_MachineImagePlan is an autogenerated conversion function.
+func Convert_azure_MachineImagePlan_To_v1alpha1_MachineImagePlan(in *azure.MachineImagePlan, out *MachineImagePlan, s conversion.Scope) error {
+	return autoConvert_azure_MachineImagePlan_To_v1alpha1_MachineImagePlan(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *MachineImageVersion, out *azure.MachineImageVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.URN = (*string)(unsafe.Pointer(in.URN))
@@ -682,6 +1191,10 @@ func autoConvert_v1alpha1_MachineImageVersion_To_azure_MachineImageVersion(in *M
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.SecurityTypes = *(*[]azure.SecurityType)(unsafe.Pointer(&in.SecurityTypes))
+	out.Regions = *(*[]azure.RegionImage)(unsafe.Pointer(&in.Regions))
+	out.Plan = (*azure.MachineImagePlan)(unsafe.Pointer(in.Plan))
+	out.OperatingSystem = (*azure.OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
 	return nil
 }
 
@@ -698,6 +1211,10 @@ func autoConvert_azure_MachineImageVersion_To_v1alpha1_MachineImageVersion(in *a
 	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.SecurityTypes = *(*[]SecurityType)(unsafe.Pointer(&in.SecurityTypes))
+	out.Regions = *(*[]RegionImage)(unsafe.Pointer(&in.Regions))
+	out.Plan = (*MachineImagePlan)(unsafe.Pointer(in.Plan))
+	out.OperatingSystem = (*OperatingSystem)(unsafe.Pointer(in.OperatingSystem))
 	return nil
 }
 
@@ -731,6 +1248,20 @@ func Convert_azure_MachineImages_To_v1alpha1_MachineImages(in *azure.MachineImag
 func autoConvert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azure.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.CacheDiskSizeGB = (*int32)(unsafe.Pointer(in.CacheDiskSizeGB))
+	out.ResourceDiskSizeGB = (*int32)(unsafe.Pointer(in.ResourceDiskSizeGB))
+	out.PremiumIO = (*bool)(unsafe.Pointer(in.PremiumIO))
+	out.UltraSSD = (*bool)(unsafe.Pointer(in.UltraSSD))
+	out.MaxNetworkInterfaces = (*int32)(unsafe.Pointer(in.MaxNetworkInterfaces))
+	out.EphemeralOSDisk = (*bool)(unsafe.Pointer(in.EphemeralOSDisk))
+	out.MaxDataDisks = (*int32)(unsafe.Pointer(in.MaxDataDisks))
+	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
+	out.SecurityTypes = *(*[]azure.SecurityType)(unsafe.Pointer(&in.SecurityTypes))
+	out.Zones = *(*[]azure.MachineTypeZones)(unsafe.Pointer(&in.Zones))
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.GPU = (*resource.Quantity)(unsafe.Pointer(in.GPU))
+	out.EphemeralStorage = (*resource.Quantity)(unsafe.Pointer(in.EphemeralStorage))
 	return nil
 }
 
@@ -742,6 +1273,20 @@ func Convert_v1alpha1_MachineType_To_azure_MachineType(in *MachineType, out *azu
 func autoConvert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, out *MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.CacheDiskSizeGB = (*int32)(unsafe.Pointer(in.CacheDiskSizeGB))
+	out.ResourceDiskSizeGB = (*int32)(unsafe.Pointer(in.ResourceDiskSizeGB))
+	out.PremiumIO = (*bool)(unsafe.Pointer(in.PremiumIO))
+	out.UltraSSD = (*bool)(unsafe.Pointer(in.UltraSSD))
+	out.MaxNetworkInterfaces = (*int32)(unsafe.Pointer(in.MaxNetworkInterfaces))
+	out.EphemeralOSDisk = (*bool)(unsafe.Pointer(in.EphemeralOSDisk))
+	out.MaxDataDisks = (*int32)(unsafe.Pointer(in.MaxDataDisks))
+	out.Architectures = *(*[]string)(unsafe.Pointer(&in.Architectures))
+	out.SecurityTypes = *(*[]SecurityType)(unsafe.Pointer(&in.SecurityTypes))
+	out.Zones = *(*[]MachineTypeZones)(unsafe.Pointer(&in.Zones))
+	out.CPU = (*resource.Quantity)(unsafe.Pointer(in.CPU))
+	out.Memory = (*resource.Quantity)(unsafe.Pointer(in.Memory))
+	out.GPU = (*resource.Quantity)(unsafe.Pointer(in.GPU))
+	out.EphemeralStorage = (*resource.Quantity)(unsafe.Pointer(in.EphemeralStorage))
 	return nil
 }
 
@@ -750,6 +1295,28 @@ func Convert_azure_MachineType_To_v1alpha1_MachineType(in *azure.MachineType, ou
 	return autoConvert_azure_MachineType_To_v1alpha1_MachineType(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineTypeZones_To_azure_MachineTypeZones(in *MachineTypeZones, out *azure.MachineTypeZones, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	return nil
+}
+
+// Convert_v1alpha1_MachineTypeZones_To_azure_MachineTypeZones is an autogenerated conversion function.
+func Convert_v1alpha1_MachineTypeZones_To_azure_MachineTypeZones(in *MachineTypeZones, out *azure.MachineTypeZones, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineTypeZones_To_azure_MachineTypeZones(in, out, s)
+}
+
+func autoConvert_azure_MachineTypeZones_To_v1alpha1_MachineTypeZones(in *azure.MachineTypeZones, out *MachineTypeZones, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
+	return nil
+}
+
+// Convert_azure_MachineTypeZones_To_v1alpha1_MachineTypeZones is an autogenerated conversion function.
+func Convert_azure_MachineTypeZones_To_v1alpha1_MachineTypeZones(in *azure.MachineTypeZones, out *MachineTypeZones, s conversion.Scope) error {
+	return autoConvert_azure_MachineTypeZones_To_v1alpha1_MachineTypeZones(in, out, s)
+}
+
 func autoConvert_v1alpha1_NatGatewayConfig_To_azure_NatGatewayConfig(in *NatGatewayConfig, out *azure.NatGatewayConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.IdleConnectionTimeoutMinutes = (*int32)(unsafe.Pointer(in.IdleConnectionTimeoutMinutes))
@@ -784,6 +1351,9 @@ func autoConvert_v1alpha1_NetworkConfig_To_azure_NetworkConfig(in *NetworkConfig
 	out.NatGateway = (*azure.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.Zones = *(*[]azure.Zone)(unsafe.Pointer(&in.Zones))
+	out.ApplicationSecurityGroups = *(*[]azure.ApplicationSecurityGroupConfig)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.SecurityRules = *(*[]azure.SecurityRule)(unsafe.Pointer(&in.SecurityRules))
+	out.AdditionalSubnets = *(*[]azure.AdditionalSubnet)(unsafe.Pointer(&in.AdditionalSubnets))
 	return nil
 }
 
@@ -800,6 +1370,9 @@ func autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.Network
 	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
 	out.ServiceEndpoints = *(*[]string)(unsafe.Pointer(&in.ServiceEndpoints))
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.ApplicationSecurityGroups = *(*[]ApplicationSecurityGroupConfig)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.SecurityRules = *(*[]SecurityRule)(unsafe.Pointer(&in.SecurityRules))
+	out.AdditionalSubnets = *(*[]AdditionalSubnet)(unsafe.Pointer(&in.AdditionalSubnets))
 	return nil
 }
 
@@ -808,6 +1381,30 @@ func Convert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in *azure.NetworkConf
 	return autoConvert_azure_NetworkConfig_To_v1alpha1_NetworkConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NetworkInterface_To_azure_NetworkInterface(in *NetworkInterface, out *azure.NetworkInterface, s conversion.Scope) error {
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.SecondaryIPConfigurations = (*int32)(unsafe.Pointer(in.SecondaryIPConfigurations))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterface_To_azure_NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterface_To_azure_NetworkInterface(in *NetworkInterface, out *azure.NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterface_To_azure_NetworkInterface(in, out, s)
+}
+
+func autoConvert_azure_NetworkInterface_To_v1alpha1_NetworkInterface(in *azure.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.AcceleratedNetworking = (*bool)(unsafe.Pointer(in.AcceleratedNetworking))
+	out.SecondaryIPConfigurations = (*int32)(unsafe.Pointer(in.SecondaryIPConfigurations))
+	return nil
+}
+
+// Convert_azure_NetworkInterface_To_v1alpha1_NetworkInterface is an autogenerated conversion function.
+func Convert_azure_NetworkInterface_To_v1alpha1_NetworkInterface(in *azure.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	return autoConvert_azure_NetworkInterface_To_v1alpha1_NetworkInterface(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkStatus_To_azure_NetworkStatus(in *NetworkStatus, out *azure.NetworkStatus, s conversion.Scope) error {
 	if err := Convert_v1alpha1_VNetStatus_To_azure_VNetStatus(&in.VNet, &out.VNet, s); err != nil {
 		return err
@@ -836,6 +1433,70 @@ func Convert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in *azure.NetworkStat
 	return autoConvert_azure_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_OSDiskConfig_To_azure_OSDiskConfig(in *OSDiskConfig, out *azure.OSDiskConfig, s conversion.Scope) error {
+	out.EphemeralPlacement = (*azure.EphemeralOSDiskPlacement)(unsafe.Pointer(in.EphemeralPlacement))
+	return nil
+}
+
+// Convert_v1alpha1_OSDiskConfig_To_azure_OSDiskConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OSDiskConfig_To_azure_OSDiskConfig(in *OSDiskConfig, out *azure.OSDiskConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OSDiskConfig_To_azure_OSDiskConfig(in, out, s)
+}
+
+func autoConvert_azure_OSDiskConfig_To_v1alpha1_OSDiskConfig(in *azure.OSDiskConfig, out *OSDiskConfig, s conversion.Scope) error {
+	out.EphemeralPlacement = (*EphemeralOSDiskPlacement)(unsafe.Pointer(in.EphemeralPlacement))
+	return nil
+}
+
+// Convert_azure_OSDiskConfig_To_v1alpha1_OSDiskConfig is an autogenerated conversion function.
+func Convert_azure_OSDiskConfig_To_v1alpha1_OSDiskConfig(in *azure.OSDiskConfig, out *OSDiskConfig, s conversion.Scope) error {
+	return autoConvert_azure_OSDiskConfig_To_v1alpha1_OSDiskConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProximityPlacementGroup_To_azure_ProximityPlacementGroup(in *ProximityPlacementGroup, out *azure.ProximityPlacementGroup, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ProximityPlacementGroup_To_azure_ProximityPlacementGroup is an autogenerated conversion function.
+func Convert_v1alpha1_ProximityPlacementGroup_To_azure_ProximityPlacementGroup(in *ProximityPlacementGroup, out *azure.ProximityPlacementGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProximityPlacementGroup_To_azure_ProximityPlacementGroup(in, out, s)
+}
+
+func autoConvert_azure_ProximityPlacementGroup_To_v1alpha1_ProximityPlacementGroup(in *azure.ProximityPlacementGroup, out *ProximityPlacementGroup, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.ID = in.ID
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_azure_ProximityPlacementGroup_To_v1alpha1_ProximityPlacementGroup is an autogenerated conversion function.
+func Convert_azure_ProximityPlacementGroup_To_v1alpha1_ProximityPlacementGroup(in *azure.ProximityPlacementGroup, out *ProximityPlacementGroup, s conversion.Scope) error {
+	return autoConvert_azure_ProximityPlacementGroup_To_v1alpha1_ProximityPlacementGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProximityPlacementGroupConfig_To_azure_ProximityPlacementGroupConfig(in *ProximityPlacementGroupConfig, out *azure.ProximityPlacementGroupConfig, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_ProximityPlacementGroupConfig_To_azure_ProximityPlacementGroupConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ProximityPlacementGroupConfig_To_azure_ProximityPlacementGroupConfig(in *ProximityPlacementGroupConfig, out *azure.ProximityPlacementGroupConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProximityPlacementGroupConfig_To_azure_ProximityPlacementGroupConfig(in, out, s)
+}
+
+func autoConvert_azure_ProximityPlacementGroupConfig_To_v1alpha1_ProximityPlacementGroupConfig(in *azure.ProximityPlacementGroupConfig, out *ProximityPlacementGroupConfig, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_azure_ProximityPlacementGroupConfig_To_v1alpha1_ProximityPlacementGroupConfig is an autogenerated conversion function.
+func Convert_azure_ProximityPlacementGroupConfig_To_v1alpha1_ProximityPlacementGroupConfig(in *azure.ProximityPlacementGroupConfig, out *ProximityPlacementGroupConfig, s conversion.Scope) error {
+	return autoConvert_azure_ProximityPlacementGroupConfig_To_v1alpha1_ProximityPlacementGroupConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PublicIPReference_To_azure_PublicIPReference(in *PublicIPReference, out *azure.PublicIPReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ResourceGroup = in.ResourceGroup
@@ -860,6 +1521,34 @@ func Convert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in *azure.Pub
 	return autoConvert_azure_PublicIPReference_To_v1alpha1_PublicIPReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RegionImage_To_azure_RegionImage(in *RegionImage, out *azure.RegionImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	return nil
+}
+
+// Convert_v1alpha1_RegionImage_To_azure_RegionImage is an autogenerated conversion function.
+func Convert_v1alpha1_RegionImage_To_azure_RegionImage(in *RegionImage, out *azure.RegionImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegionImage_To_azure_RegionImage(in, out, s)
+}
+
+func autoConvert_azure_RegionImage_To_v1alpha1_RegionImage(in *azure.RegionImage, out *RegionImage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URN = (*string)(unsafe.Pointer(in.URN))
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.CommunityGalleryImageID = (*string)(unsafe.Pointer(in.CommunityGalleryImageID))
+	out.SharedGalleryImageID = (*string)(unsafe.Pointer(in.SharedGalleryImageID))
+	return nil
+}
+
+// Convert_azure_RegionImage_To_v1alpha1_RegionImage is an autogenerated conversion function.
+func Convert_azure_RegionImage_To_v1alpha1_RegionImage(in *azure.RegionImage, out *RegionImage, s conversion.Scope) error {
+	return autoConvert_azure_RegionImage_To_v1alpha1_RegionImage(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResourceGroup_To_azure_ResourceGroup(in *ResourceGroup, out *azure.ResourceGroup, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -880,6 +1569,26 @@ func Convert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in *azure.ResourceGro
 	return autoConvert_azure_ResourceGroup_To_v1alpha1_ResourceGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_ResourceLocksConfig_To_azure_ResourceLocksConfig(in *ResourceLocksConfig, out *azure.ResourceLocksConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_ResourceLocksConfig_To_azure_ResourceLocksConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ResourceLocksConfig_To_azure_ResourceLocksConfig(in *ResourceLocksConfig, out *azure.ResourceLocksConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResourceLocksConfig_To_azure_ResourceLocksConfig(in, out, s)
+}
+
+func autoConvert_azure_ResourceLocksConfig_To_v1alpha1_ResourceLocksConfig(in *azure.ResourceLocksConfig, out *ResourceLocksConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_azure_ResourceLocksConfig_To_v1alpha1_ResourceLocksConfig is an autogenerated conversion function.
+func Convert_azure_ResourceLocksConfig_To_v1alpha1_ResourceLocksConfig(in *azure.ResourceLocksConfig, out *ResourceLocksConfig, s conversion.Scope) error {
+	return autoConvert_azure_ResourceLocksConfig_To_v1alpha1_ResourceLocksConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_RouteTable_To_azure_RouteTable(in *RouteTable, out *azure.RouteTable, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
@@ -902,6 +1611,32 @@ func Convert_azure_RouteTable_To_v1alpha1_RouteTable(in *azure.RouteTable, out *
 	return autoConvert_azure_RouteTable_To_v1alpha1_RouteTable(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecurityConfig_To_azure_SecurityConfig(in *SecurityConfig, out *azure.SecurityConfig, s conversion.Scope) error {
+	out.Type = (*azure.SecurityType)(unsafe.Pointer(in.Type))
+	out.SecureBoot = (*bool)(unsafe.Pointer(in.SecureBoot))
+	out.VTPM = (*bool)(unsafe.Pointer(in.VTPM))
+	out.OSDiskEncryptionType = (*azure.SecurityEncryptionType)(unsafe.Pointer(in.OSDiskEncryptionType))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityConfig_To_azure_SecurityConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityConfig_To_azure_SecurityConfig(in *SecurityConfig, out *azure.SecurityConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityConfig_To_azure_SecurityConfig(in, out, s)
+}
+
+func autoConvert_azure_SecurityConfig_To_v1alpha1_SecurityConfig(in *azure.SecurityConfig, out *SecurityConfig, s conversion.Scope) error {
+	out.Type = (*SecurityType)(unsafe.Pointer(in.Type))
+	out.SecureBoot = (*bool)(unsafe.Pointer(in.SecureBoot))
+	out.VTPM = (*bool)(unsafe.Pointer(in.VTPM))
+	out.OSDiskEncryptionType = (*SecurityEncryptionType)(unsafe.Pointer(in.OSDiskEncryptionType))
+	return nil
+}
+
+// Convert_azure_SecurityConfig_To_v1alpha1_SecurityConfig is an autogenerated conversion function.
+func Convert_azure_SecurityConfig_To_v1alpha1_SecurityConfig(in *azure.SecurityConfig, out *SecurityConfig, s conversion.Scope) error {
+	return autoConvert_azure_SecurityConfig_To_v1alpha1_SecurityConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecurityGroup_To_azure_SecurityGroup(in *SecurityGroup, out *azure.SecurityGroup, s conversion.Scope) error {
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Name = in.Name
@@ -924,6 +1659,94 @@ func Convert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in *azure.SecurityGro
 	return autoConvert_azure_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SecurityRule_To_azure_SecurityRule(in *SecurityRule, out *azure.SecurityRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Priority = in.Priority
+	out.Direction = azure.SecurityRuleDirection(in.Direction)
+	out.Access = azure.SecurityRuleAccess(in.Access)
+	out.Protocol = azure.SecurityRuleProtocol(in.Protocol)
+	if err := Convert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SecurityRule_To_azure_SecurityRule is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityRule_To_azure_SecurityRule(in *SecurityRule, out *azure.SecurityRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityRule_To_azure_SecurityRule(in, out, s)
+}
+
+func autoConvert_azure_SecurityRule_To_v1alpha1_SecurityRule(in *azure.SecurityRule, out *SecurityRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Priority = in.Priority
+	out.Direction = SecurityRuleDirection(in.Direction)
+	out.Access = SecurityRuleAccess(in.Access)
+	out.Protocol = SecurityRuleProtocol(in.Protocol)
+	if err := Convert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_azure_SecurityRule_To_v1alpha1_SecurityRule is an autogenerated conversion function.
+func Convert_azure_SecurityRule_To_v1alpha1_SecurityRule(in *azure.SecurityRule, out *SecurityRule, s conversion.Scope) error {
+	return autoConvert_azure_SecurityRule_To_v1alpha1_SecurityRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(in *SecurityRuleEndpoint, out *azure.SecurityRuleEndpoint, s conversion.Scope) error {
+	out.AddressPrefixes = *(*[]string)(unsafe.Pointer(&in.AddressPrefixes))
+	out.ApplicationSecurityGroups = *(*[]azure.ApplicationSecurityGroupReference)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.PortRanges = *(*[]string)(unsafe.Pointer(&in.PortRanges))
+	return nil
+}
+
+// Convert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint is an autogenerated conversion function.
+func Convert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(in *SecurityRuleEndpoint, out *azure.SecurityRuleEndpoint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecurityRuleEndpoint_To_azure_SecurityRuleEndpoint(in, out, s)
+}
+
+func autoConvert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(in *azure.SecurityRuleEndpoint, out *SecurityRuleEndpoint, s conversion.Scope) error {
+	out.AddressPrefixes = *(*[]string)(unsafe.Pointer(&in.AddressPrefixes))
+	out.ApplicationSecurityGroups = *(*[]ApplicationSecurityGroupReference)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.PortRanges = *(*[]string)(unsafe.Pointer(&in.PortRanges))
+	return nil
+}
+
+// Convert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint is an autogenerated conversion function.
+func Convert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(in *azure.SecurityRuleEndpoint, out *SecurityRuleEndpoint, s conversion.Scope) error {
+	return autoConvert_azure_SecurityRuleEndpoint_To_v1alpha1_SecurityRuleEndpoint(in, out, s)
+}
+
+func autoConvert_v1alpha1_SpotConfig_To_azure_SpotConfig(in *SpotConfig, out *azure.SpotConfig, s conversion.Scope) error {
+	out.Priority = (*azure.VMPriority)(unsafe.Pointer(in.Priority))
+	out.EvictionPolicy = (*azure.SpotEvictionPolicy)(unsafe.Pointer(in.EvictionPolicy))
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	return nil
+}
+
+// Convert_v1alpha1_SpotConfig_To_azure_SpotConfig is an autogenerated conversion function.
+func Convert_v1alpha1_SpotConfig_To_azure_SpotConfig(in *SpotConfig, out *azure.SpotConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SpotConfig_To_azure_SpotConfig(in, out, s)
+}
+
+func autoConvert_azure_SpotConfig_To_v1alpha1_SpotConfig(in *azure.SpotConfig, out *SpotConfig, s conversion.Scope) error {
+	out.Priority = (*VMPriority)(unsafe.Pointer(in.Priority))
+	out.EvictionPolicy = (*SpotEvictionPolicy)(unsafe.Pointer(in.EvictionPolicy))
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	return nil
+}
+
+// Convert_azure_SpotConfig_To_v1alpha1_SpotConfig is an autogenerated conversion function.
+func Convert_azure_SpotConfig_To_v1alpha1_SpotConfig(in *azure.SpotConfig, out *SpotConfig, s conversion.Scope) error {
+	return autoConvert_azure_SpotConfig_To_v1alpha1_SpotConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Storage_To_azure_Storage(in *Storage, out *azure.Storage, s conversion.Scope) error {
 	out.ManagedDefaultStorageClass = (*bool)(unsafe.Pointer(in.ManagedDefaultStorageClass))
 	out.ManagedDefaultVolumeSnapshotClass = (*bool)(unsafe.Pointer(in.ManagedDefaultVolumeSnapshotClass))
@@ -946,11 +1769,36 @@ func Convert_azure_Storage_To_v1alpha1_Storage(in *azure.Storage, out *Storage,
 	return autoConvert_azure_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StuckMachine_To_azure_StuckMachine(in *StuckMachine, out *azure.StuckMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.Phase = in.Phase
+	return nil
+}
+
+// Convert_v1alpha1_StuckMachine_To_azure_StuckMachine is an autogenerated conversion function.
+func Convert_v1alpha1_StuckMachine_To_azure_StuckMachine(in *StuckMachine, out *azure.StuckMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StuckMachine_To_azure_StuckMachine(in, out, s)
+}
+
+func autoConvert_azure_StuckMachine_To_v1alpha1_StuckMachine(in *azure.StuckMachine, out *StuckMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceGroup = in.ResourceGroup
+	out.Phase = in.Phase
+	return nil
+}
+
+// Convert_azure_StuckMachine_To_v1alpha1_StuckMachine is an autogenerated conversion function.
+func Convert_azure_StuckMachine_To_v1alpha1_StuckMachine(in *azure.StuckMachine, out *StuckMachine, s conversion.Scope) error {
+	return autoConvert_azure_StuckMachine_To_v1alpha1_StuckMachine(in, out, s)
+}
+
 func autoConvert_v1alpha1_Subnet_To_azure_Subnet(in *Subnet, out *azure.Subnet, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = azure.Purpose(in.Purpose)
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
+	out.AdditionalSubnetName = (*string)(unsafe.Pointer(in.AdditionalSubnetName))
 	return nil
 }
 
@@ -964,6 +1812,7 @@ func autoConvert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet,
 	out.Purpose = Purpose(in.Purpose)
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Migrated = in.Migrated
+	out.AdditionalSubnetName = (*string)(unsafe.Pointer(in.AdditionalSubnetName))
 	return nil
 }
 
@@ -972,6 +1821,38 @@ func Convert_azure_Subnet_To_v1alpha1_Subnet(in *azure.Subnet, out *Subnet, s co
 	return autoConvert_azure_Subnet_To_v1alpha1_Subnet(in, out, s)
 }
 
+func autoConvert_v1alpha1_VMExtension_To_azure_VMExtension(in *VMExtension, out *azure.VMExtension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Publisher = in.Publisher
+	out.Type = in.Type
+	out.TypeHandlerVersion = in.TypeHandlerVersion
+	out.AutoUpgradeMinorVersion = (*bool)(unsafe.Pointer(in.AutoUpgradeMinorVersion))
+	out.Settings = (*runtime.RawExtension)(unsafe.Pointer(in.Settings))
+	out.ProtectedSettingsSecretRef = (*string)(unsafe.Pointer(in.ProtectedSettingsSecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_VMExtension_To_azure_VMExtension is an autogenerated conversion function.
+func Convert_v1alpha1_VMExtension_To_azure_VMExtension(in *VMExtension, out *azure.VMExtension, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMExtension_To_azure_VMExtension(in, out, s)
+}
+
+func autoConvert_azure_VMExtension_To_v1alpha1_VMExtension(in *azure.VMExtension, out *VMExtension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Publisher = in.Publisher
+	out.Type = in.Type
+	out.TypeHandlerVersion = in.TypeHandlerVersion
+	out.AutoUpgradeMinorVersion = (*bool)(unsafe.Pointer(in.AutoUpgradeMinorVersion))
+	out.Settings = (*runtime.RawExtension)(unsafe.Pointer(in.Settings))
+	out.ProtectedSettingsSecretRef = (*string)(unsafe.Pointer(in.ProtectedSettingsSecretRef))
+	return nil
+}
+
+// Convert_azure_VMExtension_To_v1alpha1_VMExtension is an autogenerated conversion function.
+func Convert_azure_VMExtension_To_v1alpha1_VMExtension(in *azure.VMExtension, out *VMExtension, s conversion.Scope) error {
+	return autoConvert_azure_VMExtension_To_v1alpha1_VMExtension(in, out, s)
+}
+
 func autoConvert_v1alpha1_VNet_To_azure_VNet(in *VNet, out *azure.VNet, s conversion.Scope) error {
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ResourceGroup = (*string)(unsafe.Pointer(in.ResourceGroup))
@@ -1046,6 +1927,21 @@ func Convert_azure_VmoDependency_To_v1alpha1_VmoDependency(in *azure.VmoDependen
 
 func autoConvert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *azure.WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.DiskEncryption = (*azure.DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
+	out.Spot = (*azure.SpotConfig)(unsafe.Pointer(in.Spot))
+	out.OSDisk = (*azure.OSDiskConfig)(unsafe.Pointer(in.OSDisk))
+	out.DataVolumes = *(*[]azure.DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.Security = (*azure.SecurityConfig)(unsafe.Pointer(in.Security))
+	out.ProximityPlacementGroup = (*azure.ProximityPlacementGroupConfig)(unsafe.Pointer(in.ProximityPlacementGroup))
+	out.DedicatedHost = (*azure.DedicatedHostConfig)(unsafe.Pointer(in.DedicatedHost))
+	out.CapacityReservation = (*azure.CapacityReservationConfig)(unsafe.Pointer(in.CapacityReservation))
+	out.ApplicationSecurityGroups = *(*[]azure.ApplicationSecurityGroupReference)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.SecondaryIPConfigurations = (*int32)(unsafe.Pointer(in.SecondaryIPConfigurations))
+	out.AdditionalNetworkInterfaces = *(*[]azure.NetworkInterface)(unsafe.Pointer(&in.AdditionalNetworkInterfaces))
+	out.OrchestrationMode = (*azure.OrchestrationMode)(unsafe.Pointer(in.OrchestrationMode))
+	out.Extensions = *(*[]azure.VMExtension)(unsafe.Pointer(&in.Extensions))
+	out.BootDiagnostics = (*azure.BootDiagnostics)(unsafe.Pointer(in.BootDiagnostics))
 	return nil
 }
 
@@ -1056,6 +1952,21 @@ func Convert_v1alpha1_WorkerConfig_To_azure_WorkerConfig(in *WorkerConfig, out *
 
 func autoConvert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig, out *WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.DiskEncryption = (*DiskEncryption)(unsafe.Pointer(in.DiskEncryption))
+	out.Spot = (*SpotConfig)(unsafe.Pointer(in.Spot))
+	out.OSDisk = (*OSDiskConfig)(unsafe.Pointer(in.OSDisk))
+	out.DataVolumes = *(*[]DataVolume)(unsafe.Pointer(&in.DataVolumes))
+	out.Security = (*SecurityConfig)(unsafe.Pointer(in.Security))
+	out.ProximityPlacementGroup = (*ProximityPlacementGroupConfig)(unsafe.Pointer(in.ProximityPlacementGroup))
+	out.DedicatedHost = (*DedicatedHostConfig)(unsafe.Pointer(in.DedicatedHost))
+	out.CapacityReservation = (*CapacityReservationConfig)(unsafe.Pointer(in.CapacityReservation))
+	out.ApplicationSecurityGroups = *(*[]ApplicationSecurityGroupReference)(unsafe.Pointer(&in.ApplicationSecurityGroups))
+	out.SubnetName = (*string)(unsafe.Pointer(in.SubnetName))
+	out.SecondaryIPConfigurations = (*int32)(unsafe.Pointer(in.SecondaryIPConfigurations))
+	out.AdditionalNetworkInterfaces = *(*[]NetworkInterface)(unsafe.Pointer(&in.AdditionalNetworkInterfaces))
+	out.OrchestrationMode = (*OrchestrationMode)(unsafe.Pointer(in.OrchestrationMode))
+	out.Extensions = *(*[]VMExtension)(unsafe.Pointer(&in.Extensions))
+	out.BootDiagnostics = (*BootDiagnostics)(unsafe.Pointer(in.BootDiagnostics))
 	return nil
 }
 
@@ -1067,6 +1978,8 @@ func Convert_azure_WorkerConfig_To_v1alpha1_WorkerConfig(in *azure.WorkerConfig,
 func autoConvert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *azure.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]azure.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.VmoDependencies = *(*[]azure.VmoDependency)(unsafe.Pointer(&in.VmoDependencies))
+	out.ProximityPlacementGroups = *(*[]azure.ProximityPlacementGroup)(unsafe.Pointer(&in.ProximityPlacementGroups))
+	out.StuckMachines = *(*[]azure.StuckMachine)(unsafe.Pointer(&in.StuckMachines))
 	return nil
 }
 
@@ -1078,6 +1991,8 @@ func Convert_v1alpha1_WorkerStatus_To_azure_WorkerStatus(in *WorkerStatus, out *
 func autoConvert_azure_WorkerStatus_To_v1alpha1_WorkerStatus(in *azure.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.VmoDependencies = *(*[]VmoDependency)(unsafe.Pointer(&in.VmoDependencies))
+	out.ProximityPlacementGroups = *(*[]ProximityPlacementGroup)(unsafe.Pointer(&in.ProximityPlacementGroups))
+	out.StuckMachines = *(*[]StuckMachine)(unsafe.Pointer(&in.StuckMachines))
 	return nil
 }
 
@@ -26,6 +26,90 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalSubnet) DeepCopyInto(out *AdditionalSubnet) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalSubnet.
+func (in *AdditionalSubnet) DeepCopy() *AdditionalSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSecurityGroup) DeepCopyInto(out *ApplicationSecurityGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSecurityGroup.
+func (in *ApplicationSecurityGroup) DeepCopy() *ApplicationSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSecurityGroupConfig) DeepCopyInto(out *ApplicationSecurityGroupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSecurityGroupConfig.
+func (in *ApplicationSecurityGroupConfig) DeepCopy() *ApplicationSecurityGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSecurityGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSecurityGroupReference) DeepCopyInto(out *ApplicationSecurityGroupReference) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSecurityGroupReference.
+func (in *ApplicationSecurityGroupReference) DeepCopy() *ApplicationSecurityGroupReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSecurityGroupReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilitySet) DeepCopyInto(out *AvailabilitySet) {
 	*out = *in
@@ -68,6 +152,43 @@ func (in *AzureResource) DeepCopy() *AzureResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootDiagnostics) DeepCopyInto(out *BootDiagnostics) {
+	*out = *in
+	if in.StorageURI != nil {
+		in, out := &in.StorageURI, &out.StorageURI
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootDiagnostics.
+func (in *BootDiagnostics) DeepCopy() *BootDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(BootDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityReservationConfig) DeepCopyInto(out *CapacityReservationConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationConfig.
+func (in *CapacityReservationConfig) DeepCopy() *CapacityReservationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityReservationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -175,6 +296,84 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+	*out = *in
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(CachingType)
+		**out = **in
+	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ThroughputMBps != nil {
+		in, out := &in.ThroughputMBps, &out.ThroughputMBps
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DedicatedHostConfig) DeepCopyInto(out *DedicatedHostConfig) {
+	*out = *in
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DedicatedHostConfig.
+func (in *DedicatedHostConfig) DeepCopy() *DedicatedHostConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DedicatedHostConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	if in.DiskEncryptionSetID != nil {
+		in, out := &in.DiskEncryptionSetID, &out.DiskEncryptionSetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.EncryptionAtHost != nil {
+		in, out := &in.EncryptionAtHost, &out.EncryptionAtHost
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainCount) DeepCopyInto(out *DomainCount) {
 	*out = *in
@@ -199,6 +398,11 @@ func (in *IdentityConfig) DeepCopyInto(out *IdentityConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RoleAssignments != nil {
+		in, out := &in.RoleAssignments, &out.RoleAssignments
+		*out = make([]IdentityRoleAssignment, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -212,6 +416,22 @@ func (in *IdentityConfig) DeepCopy() *IdentityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityRoleAssignment) DeepCopyInto(out *IdentityRoleAssignment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityRoleAssignment.
+func (in *IdentityRoleAssignment) DeepCopy() *IdentityRoleAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityRoleAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityStatus) DeepCopyInto(out *IdentityStatus) {
 	*out = *in
@@ -243,6 +463,26 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 		*out = new(IdentityConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceLocks != nil {
+		in, out := &in.ResourceLocks, &out.ResourceLocks
+		*out = new(ResourceLocksConfig)
+		**out = **in
+	}
+	if in.DiskEncryption != nil {
+		in, out := &in.DiskEncryption, &out.DiskEncryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootDiagnostics != nil {
+		in, out := &in.BootDiagnostics, &out.BootDiagnostics
+		*out = new(BootDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrchestrationMode != nil {
+		in, out := &in.OrchestrationMode, &out.OrchestrationMode
+		*out = new(OrchestrationMode)
+		**out = **in
+	}
 	return
 }
 
@@ -324,6 +564,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 		*out = make([]SecurityGroup, len(*in))
 		copy(*out, *in)
 	}
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]ApplicationSecurityGroup, len(*in))
+		copy(*out, *in)
+	}
 	if in.Identity != nil {
 		in, out := &in.Identity, &out.Identity
 		*out = new(IdentityStatus)
@@ -383,6 +628,16 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(MachineImagePlan)
+		**out = **in
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(OperatingSystem)
+		**out = **in
+	}
 	return
 }
 
@@ -396,6 +651,22 @@ func (in *MachineImage) DeepCopy() *MachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImagePlan) DeepCopyInto(out *MachineImagePlan) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImagePlan.
+func (in *MachineImagePlan) DeepCopy() *MachineImagePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImagePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 	*out = *in
@@ -429,6 +700,28 @@ func (in *MachineImageVersion) DeepCopyInto(out *MachineImageVersion) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecurityTypes != nil {
+		in, out := &in.SecurityTypes, &out.SecurityTypes
+		*out = make([]SecurityType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]RegionImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(MachineImagePlan)
+		**out = **in
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(OperatingSystem)
+		**out = **in
+	}
 	return
 }
 
@@ -473,6 +766,78 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CacheDiskSizeGB != nil {
+		in, out := &in.CacheDiskSizeGB, &out.CacheDiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceDiskSizeGB != nil {
+		in, out := &in.ResourceDiskSizeGB, &out.ResourceDiskSizeGB
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PremiumIO != nil {
+		in, out := &in.PremiumIO, &out.PremiumIO
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UltraSSD != nil {
+		in, out := &in.UltraSSD, &out.UltraSSD
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxNetworkInterfaces != nil {
+		in, out := &in.MaxNetworkInterfaces, &out.MaxNetworkInterfaces
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EphemeralOSDisk != nil {
+		in, out := &in.EphemeralOSDisk, &out.EphemeralOSDisk
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxDataDisks != nil {
+		in, out := &in.MaxDataDisks, &out.MaxDataDisks
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityTypes != nil {
+		in, out := &in.SecurityTypes, &out.SecurityTypes
+		*out = make([]SecurityType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]MachineTypeZones, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GPU != nil {
+		in, out := &in.GPU, &out.GPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -486,6 +851,27 @@ func (in *MachineType) DeepCopy() *MachineType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTypeZones) DeepCopyInto(out *MachineTypeZones) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTypeZones.
+func (in *MachineTypeZones) DeepCopy() *MachineTypeZones {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTypeZones)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
 	*out = *in
@@ -543,15 +929,65 @@ func (in *NetworkConfig) DeepCopyInto(out *NetworkConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
-func (in *NetworkConfig) DeepCopy() *NetworkConfig {
-	if in == nil {
-		return nil
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]ApplicationSecurityGroupConfig, len(*in))
+		copy(*out, *in)
 	}
-	out := new(NetworkConfig)
+	if in.SecurityRules != nil {
+		in, out := &in.SecurityRules, &out.SecurityRules
+		*out = make([]SecurityRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalSubnets != nil {
+		in, out := &in.AdditionalSubnets, &out.AdditionalSubnets
+		*out = make([]AdditionalSubnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConfig.
+func (in *NetworkConfig) DeepCopy() *NetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	if in.SubnetName != nil {
+		in, out := &in.SubnetName, &out.SubnetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AcceleratedNetworking != nil {
+		in, out := &in.AcceleratedNetworking, &out.AcceleratedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecondaryIPConfigurations != nil {
+		in, out := &in.SecondaryIPConfigurations, &out.SecondaryIPConfigurations
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -580,6 +1016,59 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OSDiskConfig) DeepCopyInto(out *OSDiskConfig) {
+	*out = *in
+	if in.EphemeralPlacement != nil {
+		in, out := &in.EphemeralPlacement, &out.EphemeralPlacement
+		*out = new(EphemeralOSDiskPlacement)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OSDiskConfig.
+func (in *OSDiskConfig) DeepCopy() *OSDiskConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OSDiskConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProximityPlacementGroup) DeepCopyInto(out *ProximityPlacementGroup) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProximityPlacementGroup.
+func (in *ProximityPlacementGroup) DeepCopy() *ProximityPlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProximityPlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProximityPlacementGroupConfig) DeepCopyInto(out *ProximityPlacementGroupConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProximityPlacementGroupConfig.
+func (in *ProximityPlacementGroupConfig) DeepCopy() *ProximityPlacementGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProximityPlacementGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIPReference) DeepCopyInto(out *PublicIPReference) {
 	*out = *in
@@ -596,6 +1085,42 @@ func (in *PublicIPReference) DeepCopy() *PublicIPReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionImage) DeepCopyInto(out *RegionImage) {
+	*out = *in
+	if in.URN != nil {
+		in, out := &in.URN, &out.URN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommunityGalleryImageID != nil {
+		in, out := &in.CommunityGalleryImageID, &out.CommunityGalleryImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedGalleryImageID != nil {
+		in, out := &in.SharedGalleryImageID, &out.SharedGalleryImageID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionImage.
+func (in *RegionImage) DeepCopy() *RegionImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceGroup) DeepCopyInto(out *ResourceGroup) {
 	*out = *in
@@ -612,6 +1137,22 @@ func (in *ResourceGroup) DeepCopy() *ResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceLocksConfig) DeepCopyInto(out *ResourceLocksConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceLocksConfig.
+func (in *ResourceLocksConfig) DeepCopy() *ResourceLocksConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceLocksConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -628,6 +1169,42 @@ func (in *RouteTable) DeepCopy() *RouteTable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityConfig) DeepCopyInto(out *SecurityConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(SecurityType)
+		**out = **in
+	}
+	if in.SecureBoot != nil {
+		in, out := &in.SecureBoot, &out.SecureBoot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VTPM != nil {
+		in, out := &in.VTPM, &out.VTPM
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OSDiskEncryptionType != nil {
+		in, out := &in.OSDiskEncryptionType, &out.OSDiskEncryptionType
+		*out = new(SecurityEncryptionType)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityConfig.
+func (in *SecurityConfig) DeepCopy() *SecurityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -644,6 +1221,88 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityRule) DeepCopyInto(out *SecurityRule) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Destination.DeepCopyInto(&out.Destination)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRule.
+func (in *SecurityRule) DeepCopy() *SecurityRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityRuleEndpoint) DeepCopyInto(out *SecurityRuleEndpoint) {
+	*out = *in
+	if in.AddressPrefixes != nil {
+		in, out := &in.AddressPrefixes, &out.AddressPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]ApplicationSecurityGroupReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PortRanges != nil {
+		in, out := &in.PortRanges, &out.PortRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRuleEndpoint.
+func (in *SecurityRuleEndpoint) DeepCopy() *SecurityRuleEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityRuleEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotConfig) DeepCopyInto(out *SpotConfig) {
+	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(VMPriority)
+		**out = **in
+	}
+	if in.EvictionPolicy != nil {
+		in, out := &in.EvictionPolicy, &out.EvictionPolicy
+		*out = new(SpotEvictionPolicy)
+		**out = **in
+	}
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotConfig.
+func (in *SpotConfig) DeepCopy() *SpotConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
@@ -670,6 +1329,22 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckMachine) DeepCopyInto(out *StuckMachine) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckMachine.
+func (in *StuckMachine) DeepCopy() *StuckMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -678,6 +1353,11 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalSubnetName != nil {
+		in, out := &in.AdditionalSubnetName, &out.AdditionalSubnetName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -691,6 +1371,37 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMExtension) DeepCopyInto(out *VMExtension) {
+	*out = *in
+	if in.AutoUpgradeMinorVersion != nil {
+		in, out := &in.AutoUpgradeMinorVersion, &out.AutoUpgradeMinorVersion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtectedSettingsSecretRef != nil {
+		in, out := &in.ProtectedSettingsSecretRef, &out.ProtectedSettingsSecretRef
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMExtension.
+func (in *VMExtension) DeepCopy() *VMExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(VMExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNet) DeepCopyInto(out *VNet) {
 	*out = *in
@@ -773,6 +1484,89 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 		*out = new(extensionsv1alpha1.NodeTemplate)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskEncryption != nil {
+		in, out := &in.DiskEncryption, &out.DiskEncryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		*out = new(SpotConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OSDisk != nil {
+		in, out := &in.OSDisk, &out.OSDisk
+		*out = new(OSDiskConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]DataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecurityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProximityPlacementGroup != nil {
+		in, out := &in.ProximityPlacementGroup, &out.ProximityPlacementGroup
+		*out = new(ProximityPlacementGroupConfig)
+		**out = **in
+	}
+	if in.DedicatedHost != nil {
+		in, out := &in.DedicatedHost, &out.DedicatedHost
+		*out = new(DedicatedHostConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservationConfig)
+		**out = **in
+	}
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]ApplicationSecurityGroupReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubnetName != nil {
+		in, out := &in.SubnetName, &out.SubnetName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecondaryIPConfigurations != nil {
+		in, out := &in.SecondaryIPConfigurations, &out.SecondaryIPConfigurations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AdditionalNetworkInterfaces != nil {
+		in, out := &in.AdditionalNetworkInterfaces, &out.AdditionalNetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OrchestrationMode != nil {
+		in, out := &in.OrchestrationMode, &out.OrchestrationMode
+		*out = new(OrchestrationMode)
+		**out = **in
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]VMExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BootDiagnostics != nil {
+		in, out := &in.BootDiagnostics, &out.BootDiagnostics
+		*out = new(BootDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -810,6 +1604,16 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		*out = make([]VmoDependency, len(*in))
 		copy(*out, *in)
 	}
+	if in.ProximityPlacementGroups != nil {
+		in, out := &in.ProximityPlacementGroups, &out.ProximityPlacementGroups
+		*out = make([]ProximityPlacementGroup, len(*in))
+		copy(*out, *in)
+	}
+	if in.StuckMachines != nil {
+		in, out := &in.StuckMachines, &out.StuckMachines
+		*out = make([]StuckMachine, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -31,6 +31,7 @@ import (
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CloudProfileConfig{}, func(obj interface{}) { SetObjectDefaults_CloudProfileConfig(obj.(*CloudProfileConfig)) })
 	scheme.AddTypeDefaultingFunc(&ControlPlaneConfig{}, func(obj interface{}) { SetObjectDefaults_ControlPlaneConfig(obj.(*ControlPlaneConfig)) })
+	scheme.AddTypeDefaultingFunc(&WorkerConfig{}, func(obj interface{}) { SetObjectDefaults_WorkerConfig(obj.(*WorkerConfig)) })
 	return nil
 }
 
@@ -49,3 +50,16 @@ func SetObjectDefaults_ControlPlaneConfig(in *ControlPlaneConfig) {
 		SetDefaults_Storage(in.Storage)
 	}
 }
+
+func SetObjectDefaults_WorkerConfig(in *WorkerConfig) {
+	if in.Spot != nil {
+		SetDefaults_SpotConfig(in.Spot)
+	}
+	if in.Security != nil {
+		SetDefaults_SecurityConfig(in.Security)
+	}
+	for i := range in.Extensions {
+		a := &in.Extensions[i]
+		SetDefaults_VMExtension(a)
+	}
+}
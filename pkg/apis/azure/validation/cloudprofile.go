@@ -9,14 +9,17 @@ import (
 	"strings"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/strings/slices"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 )
 
-// ValidateCloudProfileConfig validates a CloudProfileConfig object.
-func ValidateCloudProfileConfig(cloudProfile *apisazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+// ValidateCloudProfileConfig validates a CloudProfileConfig object. The given regions are the regions offered by the
+// CloudProfile, for which every machine image version must provide an image reference.
+func ValidateCloudProfileConfig(cloudProfile *apisazure.CloudProfileConfig, regions []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, validateDomainCount(cloudProfile.CountFaultDomains, fldPath.Child("countFaultDomains"))...)
@@ -44,78 +47,217 @@ func ValidateCloudProfileConfig(cloudProfile *apisazure.CloudProfileConfig, fldP
 			}
 
 			allErrs = append(allErrs, validateProvidedImageIdCount(version, jdxPath)...)
+			allErrs = append(allErrs, validateImageReference(version.URN, version.ID, version.CommunityGalleryImageID, version.SharedGalleryImageID, jdxPath)...)
+			allErrs = append(allErrs, validateRegionImages(version, regions, jdxPath)...)
+			allErrs = append(allErrs, validateImagePlan(version, jdxPath)...)
 
-			if version.URN != nil {
-				if len(*version.URN) == 0 {
-					allErrs = append(allErrs, field.Required(jdxPath.Child("urn"), "urn cannot be empty when defined"))
-				} else if len(strings.Split(*version.URN, ":")) != 4 {
-					allErrs = append(allErrs, field.Invalid(jdxPath.Child("urn"), version.URN, "please use the format `Publisher:Offer:Sku:Version` for the urn"))
-				}
-			}
-			if version.ID != nil && len(*version.ID) == 0 {
-				allErrs = append(allErrs, field.Required(jdxPath.Child("id"), "id cannot be empty when defined"))
+			if !slices.Contains(v1beta1constants.ValidArchitectures, *version.Architecture) {
+				allErrs = append(allErrs, field.NotSupported(jdxPath.Child("architecture"), *version.Architecture, v1beta1constants.ValidArchitectures))
 			}
-			if version.CommunityGalleryImageID != nil {
-				if len(*version.CommunityGalleryImageID) == 0 {
-					allErrs = append(allErrs, field.Required(jdxPath.Child("communityGalleryImageID"), "communityGalleryImageID cannot be empty when defined"))
-				} else if len(strings.Split(*version.CommunityGalleryImageID, "/")) != 7 {
-					allErrs = append(allErrs, field.Invalid(jdxPath.Child("communityGalleryImageID"),
-						version.CommunityGalleryImageID, "please use the format `/CommunityGalleries/<gallery id>/Images/<image id>/versions/<version id>` for the communityGalleryImageID"))
-				} else if !strings.EqualFold(strings.Split(*version.CommunityGalleryImageID, "/")[1], "CommunityGalleries") {
-					allErrs = append(allErrs, field.Invalid(jdxPath.Child("communityGalleryImageID"),
-						version.CommunityGalleryImageID, "communityGalleryImageID must start with '/CommunityGalleries/' prefix"))
+
+			if os := version.OperatingSystem; os != nil {
+				if *os != apisazure.OperatingSystemLinux && *os != apisazure.OperatingSystemWindows {
+					allErrs = append(allErrs, field.NotSupported(jdxPath.Child("operatingSystem"), *os, []string{string(apisazure.OperatingSystemLinux), string(apisazure.OperatingSystemWindows)}))
+				} else if *os == apisazure.OperatingSystemWindows && *version.Architecture != v1beta1constants.ArchitectureAMD64 {
+					allErrs = append(allErrs, field.Forbidden(jdxPath.Child("architecture"), fmt.Sprintf("windows images are only supported for the %s architecture", v1beta1constants.ArchitectureAMD64)))
 				}
 			}
 
-			if version.SharedGalleryImageID != nil {
-				if len(*version.SharedGalleryImageID) == 0 {
-					allErrs = append(allErrs, field.Required(jdxPath.Child("sharedGalleryImageID"), "SharedGalleryImageID cannot be empty when defined"))
-				} else if len(strings.Split(*version.SharedGalleryImageID, "/")) != 7 {
-					allErrs = append(allErrs, field.Invalid(jdxPath.Child("sharedGalleryImageID"),
-						version.SharedGalleryImageID, "please use the format `/SharedGalleries/<sharedGalleryName>/Images/<sharedGalleryImageName>/Versions/<sharedGalleryImageVersionName>` for the SharedGalleryImageID"))
-				} else if !strings.EqualFold(strings.Split(*version.SharedGalleryImageID, "/")[1], "SharedGalleries") {
-					allErrs = append(allErrs, field.Invalid(jdxPath.Child("sharedGalleryImageID"),
-						version.SharedGalleryImageID, "SharedGalleryImageID must start with '/SharedGalleries/' prefix"))
+			for k, securityType := range version.SecurityTypes {
+				if securityType != apisazure.SecurityTypeTrustedLaunch && securityType != apisazure.SecurityTypeConfidentialVM {
+					allErrs = append(allErrs, field.NotSupported(jdxPath.Child("securityTypes").Index(k), securityType, []string{string(apisazure.SecurityTypeTrustedLaunch), string(apisazure.SecurityTypeConfidentialVM)}))
 				}
 			}
+		}
+	}
 
-			if !slices.Contains(v1beta1constants.ValidArchitectures, *version.Architecture) {
-				allErrs = append(allErrs, field.NotSupported(jdxPath.Child("architecture"), *version.Architecture, v1beta1constants.ValidArchitectures))
+	allErrs = append(allErrs, validateMachineTypes(cloudProfile.MachineTypes, fldPath.Child("machineTypes"))...)
+
+	return allErrs
+}
+
+// validateMachineTypes validates the capabilities of the machine types.
+func validateMachineTypes(machineTypes []apisazure.MachineType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, machineType := range machineTypes {
+		idxPath := fldPath.Index(i)
+
+		if len(machineType.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+
+		for name, quantity := range map[string]*resource.Quantity{
+			"cpu":              machineType.CPU,
+			"memory":           machineType.Memory,
+			"gpu":              machineType.GPU,
+			"ephemeralStorage": machineType.EphemeralStorage,
+		} {
+			if quantity != nil && quantity.Sign() < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child(name), quantity.String(), "must not be negative"))
+			}
+		}
+
+		if machineType.MaxDataDisks != nil && *machineType.MaxDataDisks < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxDataDisks"), *machineType.MaxDataDisks, "must not be negative"))
+		}
+
+		for j, architecture := range machineType.Architectures {
+			if !slices.Contains(v1beta1constants.ValidArchitectures, architecture) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("architectures").Index(j), architecture, v1beta1constants.ValidArchitectures))
+			}
+		}
+
+		for j, securityType := range machineType.SecurityTypes {
+			if securityType != apisazure.SecurityTypeTrustedLaunch && securityType != apisazure.SecurityTypeConfidentialVM {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("securityTypes").Index(j), securityType, []string{string(apisazure.SecurityTypeTrustedLaunch), string(apisazure.SecurityTypeConfidentialVM)}))
 			}
 		}
+
+		regions := sets.New[string]()
+		for j, zones := range machineType.Zones {
+			jdxPath := idxPath.Child("zones").Index(j)
+			if len(zones.Region) == 0 {
+				allErrs = append(allErrs, field.Required(jdxPath.Child("region"), "must provide a region"))
+			} else if regions.Has(zones.Region) {
+				allErrs = append(allErrs, field.Duplicate(jdxPath.Child("region"), zones.Region))
+			}
+			regions.Insert(zones.Region)
+		}
 	}
 
 	return allErrs
 }
 
-// validateProvidedImageIdCount validates that only one of urn/id/communityGalleryImageID/sharedGalleryImageID is provided
+// validateProvidedImageIdCount validates that only one of urn/id/communityGalleryImageID/sharedGalleryImageID is provided.
+// A version may omit the image reference if it provides region-specific ones.
 func validateProvidedImageIdCount(version apisazure.MachineImageVersion, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
-	idCount := 0
+	idCount := imageIdCount(version.URN, version.ID, version.CommunityGalleryImageID, version.SharedGalleryImageID)
 
-	if version.URN != nil {
-		idCount++
+	if idCount > 1 || (idCount == 0 && len(version.Regions) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide either urn, id, sharedGalleryImageID or communityGalleryImageID"))
 	}
 
-	if version.ID != nil {
-		idCount++
+	return allErrs
+}
+
+// validateRegionImages validates the region-specific image references of a machine image version. If the version does
+// not provide an image reference itself, it must provide one for each of the given regions.
+func validateRegionImages(version apisazure.MachineImageVersion, regions []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	regionsPath := fldPath.Child("regions")
+
+	names := sets.New[string]()
+	for i, regionImage := range version.Regions {
+		idxPath := regionsPath.Index(i)
+
+		if len(regionImage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(regionImage.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), regionImage.Name))
+		}
+		names.Insert(regionImage.Name)
+
+		if imageIdCount(regionImage.URN, regionImage.ID, regionImage.CommunityGalleryImageID, regionImage.SharedGalleryImageID) != 1 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide either urn, id, sharedGalleryImageID or communityGalleryImageID"))
+		}
+		allErrs = append(allErrs, validateImageReference(regionImage.URN, regionImage.ID, regionImage.CommunityGalleryImageID, regionImage.SharedGalleryImageID, idxPath)...)
+	}
+
+	if len(version.Regions) > 0 && imageIdCount(version.URN, version.ID, version.CommunityGalleryImageID, version.SharedGalleryImageID) == 0 {
+		for _, region := range regions {
+			if !names.Has(region) {
+				allErrs = append(allErrs, field.Required(regionsPath, fmt.Sprintf("must provide an image for region %q", region)))
+			}
+		}
 	}
 
-	if version.CommunityGalleryImageID != nil {
-		idCount++
+	return allErrs
+}
+
+// validateImagePlan validates the purchase plan of a machine image version, which only marketplace images referenced by
+// urn can have.
+func validateImagePlan(version apisazure.MachineImageVersion, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if version.Plan == nil {
+		return allErrs
 	}
+	planPath := fldPath.Child("plan")
 
-	if version.SharedGalleryImageID != nil {
-		idCount++
+	if len(version.Plan.Name) == 0 {
+		allErrs = append(allErrs, field.Required(planPath.Child("name"), "must provide a name"))
+	}
+	if len(version.Plan.Product) == 0 {
+		allErrs = append(allErrs, field.Required(planPath.Child("product"), "must provide a product"))
+	}
+	if len(version.Plan.Publisher) == 0 {
+		allErrs = append(allErrs, field.Required(planPath.Child("publisher"), "must provide a publisher"))
 	}
 
-	if idCount != 1 {
-		allErrs = append(allErrs, field.Required(fldPath, "must provide either urn, id, sharedGalleryImageID or communityGalleryImageID"))
+	if imageIdCount(version.ID, version.CommunityGalleryImageID, version.SharedGalleryImageID) > 0 {
+		allErrs = append(allErrs, field.Forbidden(planPath, "plan is only supported for images referenced by urn"))
+	}
+	for i, regionImage := range version.Regions {
+		if imageIdCount(regionImage.ID, regionImage.CommunityGalleryImageID, regionImage.SharedGalleryImageID) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("regions").Index(i), "images of versions with a plan must be referenced by urn"))
+		}
 	}
 
 	return allErrs
 }
 
+// validateImageReference validates the format of the given image references.
+func validateImageReference(urn, id, communityGalleryImageID, sharedGalleryImageID *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if urn != nil {
+		if len(*urn) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("urn"), "urn cannot be empty when defined"))
+		} else if len(strings.Split(*urn, ":")) != 4 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("urn"), urn, "please use the format `Publisher:Offer:Sku:Version` for the urn"))
+		}
+	}
+	if id != nil && len(*id) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "id cannot be empty when defined"))
+	}
+	if communityGalleryImageID != nil {
+		if len(*communityGalleryImageID) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("communityGalleryImageID"), "communityGalleryImageID cannot be empty when defined"))
+		} else if len(strings.Split(*communityGalleryImageID, "/")) != 7 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("communityGalleryImageID"),
+				communityGalleryImageID, "please use the format `/CommunityGalleries/<gallery id>/Images/<image id>/versions/<version id>` for the communityGalleryImageID"))
+		} else if !strings.EqualFold(strings.Split(*communityGalleryImageID, "/")[1], "CommunityGalleries") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("communityGalleryImageID"),
+				communityGalleryImageID, "communityGalleryImageID must start with '/CommunityGalleries/' prefix"))
+		}
+	}
+
+	if sharedGalleryImageID != nil {
+		if len(*sharedGalleryImageID) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("sharedGalleryImageID"), "SharedGalleryImageID cannot be empty when defined"))
+		} else if len(strings.Split(*sharedGalleryImageID, "/")) != 7 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sharedGalleryImageID"),
+				sharedGalleryImageID, "please use the format `/SharedGalleries/<sharedGalleryName>/Images/<sharedGalleryImageName>/Versions/<sharedGalleryImageVersionName>` for the SharedGalleryImageID"))
+		} else if !strings.EqualFold(strings.Split(*sharedGalleryImageID, "/")[1], "SharedGalleries") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sharedGalleryImageID"),
+				sharedGalleryImageID, "SharedGalleryImageID must start with '/SharedGalleries/' prefix"))
+		}
+	}
+
+	return allErrs
+}
+
+func imageIdCount(refs ...*string) int {
+	count := 0
+	for _, ref := range refs {
+		if ref != nil {
+			count++
+		}
+	}
+	return count
+}
+
 func validateDomainCount(domainCount []apisazure.DomainCount, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
@@ -61,14 +62,14 @@ var _ = Describe("CloudProfileConfig validation", func() {
 
 		Context("machine image validation", func() {
 			It("should allow valid cloudProfileConfig", func() {
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 				Expect(errorList).To(BeEmpty())
 			})
 
 			It("should enforce that at least one machine image has been defined", func() {
 				cloudProfileConfig.MachineImages = []apisazure.MachineImages{}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -79,7 +80,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			It("should forbid unsupported machine image values", func() {
 				cloudProfileConfig.MachineImages = []apisazure.MachineImages{{}}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -93,13 +94,46 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			It("should forbid unsupported machine image architecture", func() {
 				cloudProfileConfig.MachineImages[0].Versions[0].Architecture = pointer.String("foo")
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeNotSupported),
 					"Field": Equal("root.machineImages[0].versions[0].architecture"),
 				}))))
 			})
 
+			It("should forbid unsupported machine image security types", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].SecurityTypes = []apisazure.SecurityType{apisazure.SecurityTypeTrustedLaunch, "Standard"}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineImages[0].versions[0].securityTypes[1]"),
+				}))))
+			})
+
+			It("should forbid unsupported machine image operating systems", func() {
+				os := apisazure.OperatingSystem("freebsd")
+				cloudProfileConfig.MachineImages[0].Versions[0].OperatingSystem = &os
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineImages[0].versions[0].operatingSystem"),
+				}))))
+			})
+
+			It("should forbid windows machine images for other architectures than amd64", func() {
+				windows := apisazure.OperatingSystemWindows
+				cloudProfileConfig.MachineImages[0].Versions[0].OperatingSystem = &windows
+				cloudProfileConfig.MachineImages[0].Versions[0].Architecture = pointer.String("arm64")
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("root.machineImages[0].versions[0].architecture"),
+				}))))
+			})
+
 			DescribeTable("forbid unsupported machine image urn",
 				func(urn string, matcher gomegatypes.GomegaMatcher) {
 					cloudProfileConfig.MachineImages = []apisazure.MachineImages{
@@ -115,7 +149,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					}
 
-					errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 					Expect(errorList).To(matcher)
 				},
@@ -142,7 +176,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					}
 
-					errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 					Expect(errorList).To(matcher)
 				},
@@ -165,7 +199,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					}
 
-					errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 					Expect(errorList).To(matcher)
 				},
@@ -190,7 +224,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					}
 
-					errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 					Expect(errorList).To(matcher)
 				},
@@ -218,7 +252,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 						},
 					}
 
-					errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+					errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 					Expect(errorList).To(matcher)
 				},
@@ -284,7 +318,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					},
 				}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -296,11 +330,110 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			})
 		})
 
+		Context("region-specific machine image validation", func() {
+			var regions []string
+
+			BeforeEach(func() {
+				regions = []string{"westeurope", "eastus"}
+				cloudProfileConfig.MachineImages[0].Versions[0].URN = nil
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = []apisazure.RegionImage{
+					{Name: "westeurope", SharedGalleryImageID: &sharedGalleryImageID},
+					{Name: "eastus", URN: &urn},
+				}
+			})
+
+			It("should allow images for all regions", func() {
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, regions, root)).To(BeEmpty())
+			})
+
+			It("should allow missing region images if the version provides an image reference", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].URN = &urn
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = cloudProfileConfig.MachineImages[0].Versions[0].Regions[:1]
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, regions, root)).To(BeEmpty())
+			})
+
+			It("should forbid regions without an image", func() {
+				regions = append(regions, "northeurope")
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, regions, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("root.machineImages[0].versions[0].regions"),
+					"Detail": ContainSubstring("northeurope"),
+				}))))
+			})
+
+			It("should forbid invalid region images", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = []apisazure.RegionImage{
+					{Name: "westeurope", SharedGalleryImageID: &sharedGalleryImageID, URN: &urn},
+					{Name: "westeurope", URN: pointer.String("foo")},
+					{ID: &id},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, regions, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineImages[0].versions[0].regions[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineImages[0].versions[0].regions[1].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineImages[0].versions[0].regions[1].urn"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineImages[0].versions[0].regions[2].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("root.machineImages[0].versions[0].regions"),
+					"Detail": ContainSubstring("eastus"),
+				}))))
+			})
+		})
+
+		Context("machine image plan validation", func() {
+			It("should allow a plan for images referenced by urn", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Plan = &apisazure.MachineImagePlan{Name: "plan", Product: "Offer", Publisher: "Publisher"}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, nil, root)).To(BeEmpty())
+			})
+
+			It("should forbid incomplete plans", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].Plan = &apisazure.MachineImagePlan{Name: "plan"}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineImages[0].versions[0].plan.product"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineImages[0].versions[0].plan.publisher"),
+				}))))
+			})
+
+			It("should forbid a plan for images which are not referenced by urn", func() {
+				cloudProfileConfig.MachineImages[0].Versions[0].URN = nil
+				cloudProfileConfig.MachineImages[0].Versions[0].SharedGalleryImageID = &sharedGalleryImageID
+				cloudProfileConfig.MachineImages[0].Versions[0].Regions = []apisazure.RegionImage{{Name: "eastus", ID: &id}}
+				cloudProfileConfig.MachineImages[0].Versions[0].Plan = &apisazure.MachineImagePlan{Name: "plan", Product: "Offer", Publisher: "Publisher"}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("root.machineImages[0].versions[0].plan"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("root.machineImages[0].versions[0].regions[0]"),
+				}))))
+			})
+		})
+
 		Context("fault domain count validation", func() {
 			It("should enforce that at least one fault domain count has been defined", func() {
 				cloudProfileConfig.CountFaultDomains = []apisazure.DomainCount{}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -316,7 +449,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					},
 				}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -328,11 +461,77 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			})
 		})
 
+		Context("machine type validation", func() {
+			It("should allow machine types with capabilities", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{{
+					Name:   "Standard_NC6s_v3",
+					CPU:    resource.NewQuantity(6, resource.DecimalSI),
+					Memory: resource.NewQuantity(112*1024*1024*1024, resource.BinarySI),
+					GPU:    resource.NewQuantity(1, resource.DecimalSI),
+				}}
+
+				Expect(ValidateCloudProfileConfig(cloudProfileConfig, nil, root)).To(BeEmpty())
+			})
+
+			It("should forbid machine types without name and with negative capabilities", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{{
+					Memory:           resource.NewQuantity(-1, resource.BinarySI),
+					EphemeralStorage: resource.NewQuantity(-1, resource.BinarySI),
+				}}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineTypes[0].name"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineTypes[0].memory"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineTypes[0].ephemeralStorage"),
+				}))))
+			})
+
+			It("should forbid unsupported machine type capabilities", func() {
+				cloudProfileConfig.MachineTypes = []apisazure.MachineType{{
+					Name:          "Standard_D2s_v5",
+					MaxDataDisks:  pointer.Int32(-1),
+					Architectures: []string{"amd64", "foo"},
+					SecurityTypes: []apisazure.SecurityType{"foo"},
+					Zones: []apisazure.MachineTypeZones{
+						{Region: "westeurope", Names: []string{"1"}},
+						{Region: "westeurope", Names: []string{"2"}},
+						{Names: []string{"1"}},
+					},
+				}}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("root.machineTypes[0].maxDataDisks"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineTypes[0].architectures[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("root.machineTypes[0].securityTypes[0]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("root.machineTypes[0].zones[1].region"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("root.machineTypes[0].zones[2].region"),
+				}))))
+			})
+		})
+
 		Context("update domain count validation", func() {
 			It("should enforce that at least one update domain count has been defined", func() {
 				cloudProfileConfig.CountUpdateDomains = []apisazure.DomainCount{}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -348,7 +547,7 @@ var _ = Describe("CloudProfileConfig validation", func() {
 					},
 				}
 
-				errorList := ValidateCloudProfileConfig(cloudProfileConfig, root)
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, nil, root)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
@@ -6,7 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
@@ -22,8 +27,15 @@ import (
 const (
 	natGatewayMinTimeoutInMinutes int32 = 4
 	natGatewayMaxTimeoutInMinutes int32 = 120
+
+	applicationSecurityGroupResourceType = "Microsoft.Network/applicationSecurityGroups"
+	// the cloud-controller-manager allocates the priorities of its rules starting at 500.
+	securityRuleMinPriority int32 = 100
+	securityRuleMaxPriority int32 = 499
 )
 
+var resourceNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
 // ValidateInfrastructureConfigAgainstCloudProfile validates the InfrastructureConfig against the CloudProfile.
 func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *apisazure.InfrastructureConfig, shootRegion string, cloudProfile *gardencorev1beta1.CloudProfile, fld *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -109,21 +121,291 @@ func ValidateInfrastructureConfig(infra *apisazure.InfrastructureConfig, network
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("zoned"), infra.Zoned, fmt.Sprintf("specifying a zoned cluster and having the %q annotation is not allowed", azure.ShootVmoUsageAnnotation)))
 	}
 
-	allErrs = append(allErrs, validateNetworkConfig(infra, nodes, pods, services, hasVmoAlphaAnnotation, fldPath)...)
+	allErrs = append(allErrs, validateOrchestrationMode(infra, hasVmoAlphaAnnotation, fldPath.Child("orchestrationMode"))...)
+	allErrs = append(allErrs, validateNetworkConfig(infra, nodes, pods, services, helper.IsVmoConfigured(infra, hasVmoAlphaAnnotation), fldPath)...)
+
+	if infra.Identity != nil {
+		allErrs = append(allErrs, validateIdentityConfig(infra.Identity, fldPath.Child("identity"))...)
+	}
+
+	allErrs = append(allErrs, validateDiskEncryption(infra.DiskEncryption, fldPath.Child("diskEncryption"))...)
+	allErrs = append(allErrs, validateBootDiagnostics(infra.BootDiagnostics, fldPath.Child("bootDiagnostics"))...)
+	allErrs = append(allErrs, validateApplicationSecurityGroups(infra.Networks.ApplicationSecurityGroups, fldPath.Child("networks", "applicationSecurityGroups"))...)
+	allErrs = append(allErrs, validateSecurityRules(infra.Networks.SecurityRules, applicationSecurityGroupNames(infra), fldPath.Child("networks", "securityRules"))...)
+	allErrs = append(allErrs, validateAdditionalSubnets(infra, nodes, pods, services, fldPath.Child("networks"))...)
+
+	return allErrs
+}
+
+func validateAdditionalSubnets(infra *apisazure.InfrastructureConfig, nodes, pods, services cidrvalidation.CIDR, networksPath *field.Path) field.ErrorList {
+	var (
+		fldPath   = networksPath.Child("additionalSubnets")
+		allErrs   = field.ErrorList{}
+		config    = infra.Networks
+		names     = sets.New[string]()
+		zoneNames = sets.New[int32]()
+		cidrs     []cidrvalidation.CIDR
+	)
+
+	if len(config.AdditionalSubnets) == 0 {
+		return allErrs
+	}
+
+	// the default vnet has the CIDR of the workers subnet and leaves no room for additional subnets.
+	if isDefaultVnetConfig(&config.VNet) {
+		return append(allErrs, field.Forbidden(fldPath, "additional subnets require a vnet cidr or an existing vnet"))
+	}
+
+	for _, zone := range config.Zones {
+		zoneNames.Insert(zone.Name)
+	}
+
+	for i, subnet := range config.AdditionalSubnets {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, validateResourceName(subnet.Name, 30, idxPath.Child("name"))...)
+		if names.Has(subnet.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), subnet.Name))
+		}
+		names.Insert(subnet.Name)
+
+		cidr := cidrvalidation.NewCIDR(subnet.CIDR, idxPath.Child("cidr"))
+		cidrs = append(cidrs, cidr)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidr.GetFieldPath(), cidr.GetCIDR())...)
+		if config.VNet.CIDR != nil {
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(*config.VNet.CIDR, networksPath.Child("vnet", "cidr")).ValidateSubset(cidr)...)
+		}
+
+		switch {
+		case helper.IsUsingSingleSubnetLayout(infra) && subnet.Zone != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("zone"), "zone can only be specified if the cluster uses a subnet per zone"))
+		case !helper.IsUsingSingleSubnetLayout(infra) && subnet.Zone == nil:
+			allErrs = append(allErrs, field.Required(idxPath.Child("zone"), "zone must be specified if the cluster uses a subnet per zone"))
+		case subnet.Zone != nil && !zoneNames.Has(*subnet.Zone):
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("zone"), *subnet.Zone))
+		}
+	}
+
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrs...)...)
+	if nodes != nil {
+		allErrs = append(allErrs, nodes.ValidateSubset(cidrs...)...)
+	}
+	if pods != nil {
+		allErrs = append(allErrs, pods.ValidateNotOverlap(cidrs...)...)
+	}
+	if services != nil {
+		allErrs = append(allErrs, services.ValidateNotOverlap(cidrs...)...)
+	}
+
+	// the additional subnets must neither overlap with each other nor with the subnets of the workers or zones.
+	subnetCIDRs := append([]cidrvalidation.CIDR{}, cidrs...)
+	if config.Workers != nil {
+		subnetCIDRs = append(subnetCIDRs, cidrvalidation.NewCIDR(*config.Workers, networksPath.Child("workers")))
+	}
+	for i, zone := range config.Zones {
+		subnetCIDRs = append(subnetCIDRs, cidrvalidation.NewCIDR(zone.CIDR, networksPath.Child("zones").Index(i).Child("cidr")))
+	}
+	allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(subnetCIDRs, false)...)
+
+	return allErrs
+}
+
+func validateApplicationSecurityGroups(applicationSecurityGroups []apisazure.ApplicationSecurityGroupConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, asg := range applicationSecurityGroups {
+		namePath := fldPath.Index(i).Child("name")
+		allErrs = append(allErrs, validateResourceName(asg.Name, 30, namePath)...)
+		if names.Has(asg.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, asg.Name))
+		}
+		names.Insert(asg.Name)
+	}
+
+	return allErrs
+}
 
-	if infra.Identity != nil && (infra.Identity.Name == "" || infra.Identity.ResourceGroup == "") {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("identity"), infra.Identity, "specifying an identity requires the name of the identity and the resource group which hosts the identity"))
+func validateSecurityRules(rules []apisazure.SecurityRule, applicationSecurityGroupNames sets.Set[string], fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs    = field.ErrorList{}
+		names      = sets.New[string]()
+		priorities = sets.New[string]()
+	)
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, validateResourceName(rule.Name, 50, idxPath.Child("name"))...)
+		if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		if rule.Priority < securityRuleMinPriority || rule.Priority > securityRuleMaxPriority {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priority"), rule.Priority, fmt.Sprintf("must be between %d and %d", securityRuleMinPriority, securityRuleMaxPriority)))
+		}
+		// priorities must be unique per direction.
+		if priority := fmt.Sprintf("%s/%d", rule.Direction, rule.Priority); priorities.Has(priority) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("priority"), rule.Priority))
+		} else {
+			priorities.Insert(priority)
+		}
+
+		if !slices.Contains([]apisazure.SecurityRuleDirection{apisazure.SecurityRuleDirectionInbound, apisazure.SecurityRuleDirectionOutbound}, rule.Direction) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("direction"), rule.Direction, []string{string(apisazure.SecurityRuleDirectionInbound), string(apisazure.SecurityRuleDirectionOutbound)}))
+		}
+		if !slices.Contains([]apisazure.SecurityRuleAccess{apisazure.SecurityRuleAccessAllow, apisazure.SecurityRuleAccessDeny}, rule.Access) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("access"), rule.Access, []string{string(apisazure.SecurityRuleAccessAllow), string(apisazure.SecurityRuleAccessDeny)}))
+		}
+		protocols := []apisazure.SecurityRuleProtocol{apisazure.SecurityRuleProtocolTCP, apisazure.SecurityRuleProtocolUDP, apisazure.SecurityRuleProtocolICMP, apisazure.SecurityRuleProtocolAny}
+		if !slices.Contains(protocols, rule.Protocol) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), rule.Protocol, []string{string(apisazure.SecurityRuleProtocolTCP), string(apisazure.SecurityRuleProtocolUDP), string(apisazure.SecurityRuleProtocolICMP), string(apisazure.SecurityRuleProtocolAny)}))
+		}
+
+		allErrs = append(allErrs, validateSecurityRuleEndpoint(rule.Source, applicationSecurityGroupNames, idxPath.Child("source"))...)
+		allErrs = append(allErrs, validateSecurityRuleEndpoint(rule.Destination, applicationSecurityGroupNames, idxPath.Child("destination"))...)
+	}
+
+	return allErrs
+}
+
+func validateSecurityRuleEndpoint(endpoint apisazure.SecurityRuleEndpoint, applicationSecurityGroupNames sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(endpoint.AddressPrefixes) > 0 && len(endpoint.ApplicationSecurityGroups) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("applicationSecurityGroups"), "cannot be specified together with addressPrefixes"))
+	}
+	for i, prefix := range endpoint.AddressPrefixes {
+		if prefix == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("addressPrefixes").Index(i), "address prefix must not be empty"))
+		}
+	}
+	for i, ref := range endpoint.ApplicationSecurityGroups {
+		allErrs = append(allErrs, validateApplicationSecurityGroupReference(ref, applicationSecurityGroupNames, fldPath.Child("applicationSecurityGroups").Index(i))...)
+	}
+	for i, portRange := range endpoint.PortRanges {
+		if !isValidPortRange(portRange) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("portRanges").Index(i), portRange, "must be *, a port or a range of ports like 8000-8080"))
+		}
 	}
 
 	return allErrs
 }
 
+// validateApplicationSecurityGroupReference validates a reference to an application security group. Names are only
+// checked against the given application security groups of the shoot if they are known.
+func validateApplicationSecurityGroupReference(ref apisazure.ApplicationSecurityGroupReference, applicationSecurityGroupNames sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case (ref.Name == nil) == (ref.ID == nil):
+		allErrs = append(allErrs, field.Invalid(fldPath, ref, "exactly one of name or id must be specified"))
+	case ref.Name != nil:
+		if applicationSecurityGroupNames != nil && !applicationSecurityGroupNames.Has(*ref.Name) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("name"), *ref.Name))
+		}
+	default:
+		idPath := fldPath.Child("id")
+		id, err := arm.ParseResourceID(*ref.ID)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idPath, *ref.ID, fmt.Sprintf("must be a valid resource id: %v", err)))
+		} else if !strings.EqualFold(id.ResourceType.String(), applicationSecurityGroupResourceType) {
+			allErrs = append(allErrs, field.Invalid(idPath, *ref.ID, fmt.Sprintf("must be the id of a resource of type %s", applicationSecurityGroupResourceType)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateResourceName(name string, maxLength int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) > maxLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, name, maxLength))
+	}
+	if !resourceNameRegex.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character"))
+	}
+
+	return allErrs
+}
+
+func isValidPortRange(portRange string) bool {
+	if portRange == "*" {
+		return true
+	}
+	from, to, isRange := strings.Cut(portRange, "-")
+	if !isValidPort(from) || (isRange && !isValidPort(to)) {
+		return false
+	}
+	if isRange {
+		fromPort, _ := strconv.Atoi(from)
+		toPort, _ := strconv.Atoi(to)
+		return fromPort <= toPort
+	}
+	return true
+}
+
+func isValidPort(port string) bool {
+	p, err := strconv.Atoi(port)
+	return err == nil && p >= 0 && p <= 65535 && strconv.Itoa(p) == port
+}
+
+func applicationSecurityGroupNames(infra *apisazure.InfrastructureConfig) sets.Set[string] {
+	names := sets.New[string]()
+	for _, asg := range infra.Networks.ApplicationSecurityGroups {
+		names.Insert(asg.Name)
+	}
+	return names
+}
+
+func additionalSubnetNames(infra *apisazure.InfrastructureConfig) sets.Set[string] {
+	names := sets.New[string]()
+	for _, subnet := range infra.Networks.AdditionalSubnets {
+		names.Insert(subnet.Name)
+	}
+	return names
+}
+
+func validateIdentityConfig(identity *apisazure.IdentityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !identity.Managed {
+		if identity.Name == "" || identity.ResourceGroup == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, identity, "specifying an identity requires the name of the identity and the resource group which hosts the identity"))
+		}
+		if len(identity.RoleAssignments) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("roleAssignments"), "role assignments can only be specified for a managed identity"))
+		}
+		return allErrs
+	}
+
+	if identity.Name != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "the name of a managed identity is determined by Gardener"))
+	}
+	if identity.ResourceGroup != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceGroup"), "a managed identity is always created in the shoot's resource group"))
+	}
+
+	for i, ra := range identity.RoleAssignments {
+		raPath := fldPath.Child("roleAssignments").Index(i)
+		if ra.RoleDefinitionID == "" {
+			allErrs = append(allErrs, field.Required(raPath.Child("roleDefinitionID"), "role definition ID must be specified"))
+		}
+		if !strings.HasPrefix(strings.ToLower(ra.Scope), "/subscriptions/") {
+			allErrs = append(allErrs, field.Invalid(raPath.Child("scope"), ra.Scope, "scope must be the ID of a subscription, resource group or resource"))
+		}
+	}
+	return allErrs
+}
+
 func validateNetworkConfig(
 	infra *apisazure.InfrastructureConfig,
 	nodes cidrvalidation.CIDR,
 	pods cidrvalidation.CIDR,
 	services cidrvalidation.CIDR,
-	hasVmoAlphaAnnotation bool,
+	vmoConfigured bool,
 	fldPath *field.Path,
 ) field.ErrorList {
 
@@ -164,7 +446,7 @@ func validateNetworkConfig(
 			allErrs = append(allErrs, nodes.ValidateSubset(workerCIDR)...)
 		}
 
-		allErrs = append(allErrs, validateNatGatewayConfig(config.NatGateway, infra.Zoned, hasVmoAlphaAnnotation, networksPath.Child("natGateway"))...)
+		allErrs = append(allErrs, validateNatGatewayConfig(config.NatGateway, infra.Zoned, vmoConfigured, networksPath.Child("natGateway"))...)
 		return allErrs
 	}
 
@@ -282,7 +564,7 @@ func validateZones(zones []apisazure.Zone, nodes, pods, services cidrvalidation.
 	return allErrs
 }
 
-func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, zoned bool, hasVmoAlphaAnnotation bool, natGatewayPath *field.Path) field.ErrorList {
+func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, zoned bool, vmoConfigured bool, natGatewayPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if natGatewayConfig == nil {
@@ -299,7 +581,7 @@ func validateNatGatewayConfig(natGatewayConfig *apisazure.NatGatewayConfig, zone
 	// NatGateway cannot be offered for Shoot clusters with a primary AvailabilitySet.
 	// The NatGateway is not compatible with the Basic SKU Loadbalancers which are
 	// required to use for Shoot clusters with AvailabilitySet.
-	if !zoned && !hasVmoAlphaAnnotation {
+	if !zoned && !vmoConfigured {
 		return append(allErrs, field.Forbidden(natGatewayPath, "NatGateway is currently only supported for zonal and VMO clusters"))
 	}
 
@@ -389,24 +671,94 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisazure.Infrastr
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Workers, oldConfig.Networks.Workers, providerPath.Child("networks").Child("workers"))...)
 	}
 
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldConfig.Zoned, newConfig.Zoned, providerPath.Child("zoned"))...)
+	// non-zonal clusters can be migrated to zones, see ValidateZoneMigration.
+	if oldConfig.Zoned {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldConfig.Zoned, newConfig.Zoned, providerPath.Child("zoned"))...)
+	}
 	allErrs = append(allErrs, validateVnetConfigUpdate(&oldConfig.Networks, &newConfig.Networks, providerPath.Child("networks"))...)
 
+	for i, newSubnet := range newConfig.Networks.AdditionalSubnets {
+		for _, oldSubnet := range oldConfig.Networks.AdditionalSubnets {
+			if newSubnet.Name == oldSubnet.Name {
+				allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSubnet.CIDR, oldSubnet.CIDR, providerPath.Child("networks", "additionalSubnets").Index(i).Child("cidr"))...)
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVmoConfigUpdate validates the VMO configuration on update. Clusters using an availability set can only be
+// migrated to VMOs after all of their worker pools have been migrated, while VMO clusters cannot be migrated back.
+func ValidateVmoConfigUpdate(oldVmoConfigured, newVmoConfigured, workerPoolsMigrated bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !oldVmoConfigured && newVmoConfigured && !workerPoolsMigrated {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("migrating an existing cluster to %s requires all worker pools to be migrated via their orchestrationMode first", apisazure.OrchestrationModeVMSSFlex)))
+	}
+
+	if oldVmoConfigured && !newVmoConfigured {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("not allowed to migrate an existing cluster from %s to %s or to zones, neither via the orchestrationMode nor by removing the annotation %q", apisazure.OrchestrationModeVMSSFlex, apisazure.OrchestrationModeAvailabilitySet, azure.ShootVmoUsageAnnotation)))
+	}
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigAgainstReconciler validates that the InfrastructureConfig does not newly enable features
+// which are only implemented by the flow reconciler if the shoot's infrastructure is not reconciled with flow.
+func ValidateInfrastructureConfigAgainstReconciler(oldInfra, infra *apisazure.InfrastructureConfig, usesFlow bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if usesFlow {
+		return allErrs
+	}
+
+	detail := fmt.Sprintf("is only supported if the infrastructure is reconciled with flow, use the %q annotation to enable it", azure.AnnotationKeyUseFlow)
+	if resourceLocksEnabled(infra) && (oldInfra == nil || !resourceLocksEnabled(oldInfra)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceLocks", "enabled"), "locking the infrastructure resources "+detail))
+	}
+	if managedIdentity(infra) && (oldInfra == nil || !managedIdentity(oldInfra)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("identity", "managed"), "a managed identity "+detail))
+	}
+	if len(infra.Networks.ApplicationSecurityGroups) > 0 && (oldInfra == nil || len(oldInfra.Networks.ApplicationSecurityGroups) == 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "applicationSecurityGroups"), "application security groups "+detail))
+	}
+	if len(infra.Networks.SecurityRules) > 0 && (oldInfra == nil || len(oldInfra.Networks.SecurityRules) == 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "securityRules"), "security rules "+detail))
+	}
+	if len(infra.Networks.AdditionalSubnets) > 0 && (oldInfra == nil || len(oldInfra.Networks.AdditionalSubnets) == 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("networks", "additionalSubnets"), "additional subnets "+detail))
+	}
+
 	return allErrs
 }
 
-// ValidateVmoConfigUpdate validates the VMO configuration on update.
-func ValidateVmoConfigUpdate(oldShootHasAlphaVmoAnnotation, newShootHasAlphaVmoAnnotation bool, metaDataPath *field.Path) field.ErrorList {
+func managedIdentity(infra *apisazure.InfrastructureConfig) bool {
+	return infra.Identity != nil && infra.Identity.Managed
+}
+
+func resourceLocksEnabled(infra *apisazure.InfrastructureConfig) bool {
+	return infra.ResourceLocks != nil && infra.ResourceLocks.Enabled
+}
+
+func validateOrchestrationMode(infra *apisazure.InfrastructureConfig, hasVmoAlphaAnnotation bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// Check if old shoot has not the vmo alpha annotation and forbid to add it.
-	if !oldShootHasAlphaVmoAnnotation && newShootHasAlphaVmoAnnotation {
-		allErrs = append(allErrs, field.Forbidden(metaDataPath.Child("annotations"), fmt.Sprintf("not allowed to add annotation %q to an already existing shoot cluster", azure.ShootVmoUsageAnnotation)))
+	if infra.OrchestrationMode == nil {
+		return allErrs
 	}
 
-	// Check if old shoot has the vmo alpha annotaion and forbid to remove it.
-	if oldShootHasAlphaVmoAnnotation && !newShootHasAlphaVmoAnnotation {
-		allErrs = append(allErrs, field.Forbidden(metaDataPath.Child("annotations"), fmt.Sprintf("not allowed to remove annotation %q to an already existing shoot cluster", azure.ShootVmoUsageAnnotation)))
+	switch *infra.OrchestrationMode {
+	case apisazure.OrchestrationModeAvailabilitySet, apisazure.OrchestrationModeVMSSFlex:
+	default:
+		return append(allErrs, field.NotSupported(fldPath, *infra.OrchestrationMode, []string{string(apisazure.OrchestrationModeAvailabilitySet), string(apisazure.OrchestrationModeVMSSFlex)}))
+	}
+
+	if infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the orchestration mode can only be set for non-zonal clusters"))
+	}
+	if *infra.OrchestrationMode == apisazure.OrchestrationModeAvailabilitySet && hasVmoAlphaAnnotation {
+		allErrs = append(allErrs, field.Invalid(fldPath, *infra.OrchestrationMode, fmt.Sprintf("conflicts with the annotation %q", azure.ShootVmoUsageAnnotation)))
 	}
 
 	return allErrs
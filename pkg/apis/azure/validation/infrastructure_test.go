@@ -212,6 +212,55 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			})
 		})
 
+		Context("orchestration mode", func() {
+			It("should allow the VMSSFlex orchestration mode for a non-zonal cluster", func() {
+				mode := apisazure.OrchestrationModeVMSSFlex
+				infrastructureConfig.OrchestrationMode = &mode
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an unknown orchestration mode", func() {
+				mode := apisazure.OrchestrationMode("Uniform")
+				infrastructureConfig.OrchestrationMode = &mode
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("orchestrationMode"),
+				}))
+			})
+
+			It("should forbid setting the orchestration mode for a zonal cluster", func() {
+				mode := apisazure.OrchestrationModeVMSSFlex
+				infrastructureConfig.OrchestrationMode = &mode
+				infrastructureConfig.Zoned = true
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("orchestrationMode"),
+				}))
+			})
+
+			It(fmt.Sprintf("should forbid the AvailabilitySet orchestration mode in combination with the %q annotation", azure.ShootVmoUsageAnnotation), func() {
+				mode := apisazure.OrchestrationModeAvailabilitySet
+				infrastructureConfig.OrchestrationMode = &mode
+				hasVmoAlphaAnnotation = true
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("orchestrationMode"),
+				}))
+			})
+		})
+
 		Context("CIDR", func() {
 			It("should forbid invalid VNet CIDRs", func() {
 				infrastructureConfig.Networks.VNet.CIDR = &invalidCIDR
@@ -347,6 +396,245 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Field": Equal("identity"),
 				}))
 			})
+
+			It("should return no errors for a managed identity with role assignments", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Managed: true,
+					RoleAssignments: []apisazure.IdentityRoleAssignment{
+						{RoleDefinitionID: "7f951dda-4ed3-4680-a7ca-43fe172d538d", Scope: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ContainerRegistry/registries/acr"},
+					},
+				}
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid a name and resource group for a managed identity and validate the role assignments", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:            "test-identity",
+					ResourceGroup:   "identity-resource-group",
+					Managed:         true,
+					RoleAssignments: []apisazure.IdentityRoleAssignment{{Scope: "rg"}},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("identity.name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("identity.resourceGroup"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("identity.roleAssignments[0].roleDefinitionID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("identity.roleAssignments[0].scope"),
+				}))
+			})
+
+			It("should forbid role assignments for a referenced identity", func() {
+				infrastructureConfig.Identity = &apisazure.IdentityConfig{
+					Name:            "test-identity",
+					ResourceGroup:   "identity-resource-group",
+					RoleAssignments: []apisazure.IdentityRoleAssignment{{RoleDefinitionID: "foo", Scope: "/subscriptions/sub"}},
+				}
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("identity.roleAssignments"),
+				}))
+			})
+		})
+
+		Context("DiskEncryption", func() {
+			It("should forbid a disk encryption set id which is not a valid resource id", func() {
+				infrastructureConfig.DiskEncryption = &apisazure.DiskEncryption{DiskEncryptionSetID: pointer.String("foo")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("diskEncryption.diskEncryptionSetID"),
+				}))
+			})
+		})
+
+		Context("BootDiagnostics", func() {
+			It("should forbid a storage URI which is not a blob endpoint", func() {
+				infrastructureConfig.BootDiagnostics = &apisazure.BootDiagnostics{Enabled: true, StorageURI: pointer.String("mystorageaccount")}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("bootDiagnostics.storageURI"),
+				}))
+			})
+		})
+
+		Context("ApplicationSecurityGroups and SecurityRules", func() {
+			var rule apisazure.SecurityRule
+
+			BeforeEach(func() {
+				infrastructureConfig.Networks.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupConfig{{Name: "web"}}
+				rule = apisazure.SecurityRule{
+					Name:      "allow-https",
+					Priority:  100,
+					Direction: apisazure.SecurityRuleDirectionInbound,
+					Access:    apisazure.SecurityRuleAccessAllow,
+					Protocol:  apisazure.SecurityRuleProtocolTCP,
+					Source:    apisazure.SecurityRuleEndpoint{AddressPrefixes: []string{"10.0.0.0/8"}},
+					Destination: apisazure.SecurityRuleEndpoint{
+						ApplicationSecurityGroups: []apisazure.ApplicationSecurityGroupReference{{Name: pointer.String("web")}},
+						PortRanges:                []string{"443", "8000-8080"},
+					},
+				}
+			})
+
+			It("should allow valid application security groups and rules", func() {
+				infrastructureConfig.Networks.SecurityRules = []apisazure.SecurityRule{rule}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid and duplicate application security group names", func() {
+				infrastructureConfig.Networks.ApplicationSecurityGroups = append(infrastructureConfig.Networks.ApplicationSecurityGroups,
+					apisazure.ApplicationSecurityGroupConfig{Name: "web"},
+					apisazure.ApplicationSecurityGroupConfig{Name: "Web_"},
+				)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.applicationSecurityGroups[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.applicationSecurityGroups[2].name"),
+				}))
+			})
+
+			It("should forbid priorities which collide with the cloud-controller-manager or other rules", func() {
+				other := *rule.DeepCopy()
+				other.Name = "other"
+				rule.Priority = 500
+				other.Priority = 500
+				infrastructureConfig.Networks.SecurityRules = []apisazure.SecurityRule{rule, other}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityRules[0].priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityRules[1].priority"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.securityRules[1].priority"),
+				}))
+			})
+
+			It("should forbid invalid rule endpoints", func() {
+				rule.Source.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupReference{{Name: pointer.String("db")}}
+				rule.Destination.PortRanges = []string{"80-70", "65536", "*"}
+				infrastructureConfig.Networks.SecurityRules = []apisazure.SecurityRule{rule}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.securityRules[0].source.applicationSecurityGroups"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("networks.securityRules[0].source.applicationSecurityGroups[0].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityRules[0].destination.portRanges[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.securityRules[0].destination.portRanges[1]"),
+				}))
+			})
+
+			It("should forbid unsupported directions, accesses and protocols", func() {
+				rule.Direction = "Sideways"
+				rule.Access = "Maybe"
+				rule.Protocol = "Esp"
+				infrastructureConfig.Networks.SecurityRules = []apisazure.SecurityRule{rule}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.securityRules[0].direction"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.securityRules[0].access"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.securityRules[0].protocol"),
+				}))
+			})
+		})
+
+		Context("AdditionalSubnets", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{{Name: "dmz", CIDR: "10.250.4.0/24"}}
+			})
+
+			It("should allow additional subnets in the vnet", func() {
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)).To(BeEmpty())
+			})
+
+			It("should forbid additional subnets in the default vnet", func() {
+				infrastructureConfig.Networks.VNet = apisazure.VNet{}
+				networking.Nodes = infrastructureConfig.Networks.Workers
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.additionalSubnets"),
+				}))
+			})
+
+			It("should forbid duplicate names and overlapping CIDRs", func() {
+				infrastructureConfig.Networks.AdditionalSubnets = append(infrastructureConfig.Networks.AdditionalSubnets,
+					apisazure.AdditionalSubnet{Name: "dmz", CIDR: "10.250.3.128/25"},
+				)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.additionalSubnets[1].name"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.workers"),
+				}))
+			})
+
+			It("should forbid CIDRs outside of the vnet and the nodes network", func() {
+				infrastructureConfig.Networks.AdditionalSubnets[0].CIDR = "192.168.0.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalSubnets[0].cidr"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.additionalSubnets[0].cidr"),
+				}))
+			})
+
+			It("should require a configured zone if the cluster uses a subnet per zone", func() {
+				infrastructureConfig.Zoned = true
+				infrastructureConfig.Networks.Workers = nil
+				infrastructureConfig.Networks.Zones = []apisazure.Zone{{Name: 1, CIDR: "10.250.0.0/24"}}
+				infrastructureConfig.Networks.AdditionalSubnets = append(infrastructureConfig.Networks.AdditionalSubnets,
+					apisazure.AdditionalSubnet{Name: "other", CIDR: "10.250.5.0/24", Zone: pointer.Int32(2)},
+				)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, hasVmoAlphaAnnotation, providerPath)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.additionalSubnets[0].zone"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("networks.additionalSubnets[1].zone"),
+				}))
+			})
 		})
 
 		Context("NatGateway", func() {
@@ -778,8 +1066,8 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			},
 			Entry("should pass as old and new cluster are zoned", true, true, false),
 			Entry("should pass as old and new cluster are non-zoned", false, false, false),
-			Entry("should forbid moving a zoned cluster to a non-zoned cluster", false, true, true),
-			Entry("should forbid moving a non-zoned cluster to a zoned cluster", true, false, true),
+			Entry("should forbid moving a zoned cluster to a non-zoned cluster", true, false, true),
+			Entry("should allow migrating a non-zoned cluster to a zoned cluster", false, true, false),
 		)
 
 		Context("Infrastructure Zones", func() {
@@ -910,10 +1198,10 @@ var _ = Describe("InfrastructureConfig validation", func() {
 	})
 
 	DescribeTable("#ValidateVmoConfigUpdate",
-		func(newHasVmoAlphaAnnotation, oldHasVmoAlphaAnnotation, expectErrors bool) {
+		func(oldVmoConfigured, newVmoConfigured, workerPoolsMigrated, expectErrors bool) {
 			var (
-				path      *field.Path
-				errorList = ValidateVmoConfigUpdate(newHasVmoAlphaAnnotation, oldHasVmoAlphaAnnotation, path)
+				path      = field.NewPath("orchestrationMode")
+				errorList = ValidateVmoConfigUpdate(oldVmoConfigured, newVmoConfigured, workerPoolsMigrated, path)
 			)
 			if !expectErrors {
 				Expect(errorList).To(HaveLen(0))
@@ -921,12 +1209,78 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}
 			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("annotations"),
+				"Field": Equal("orchestrationMode"),
 			}))))
 		},
-		Entry("should pass as old and new cluster have vmo alpha annotation", true, true, false),
-		Entry("should pass as old and new cluster don't have vmo alpha annotation", false, false, false),
-		Entry("should forbid removing the vmo alpha annotation for an already existing cluster", true, false, true),
-		Entry("should forbid adding the vmo alpha annotation to an already existing cluster", false, true, true),
+		Entry("should pass as old and new cluster use vmos", true, true, false, false),
+		Entry("should pass as old and new cluster use an availability set", false, false, false, false),
+		Entry("should forbid migrating an existing cluster from vmos to an availability set", true, false, true, true),
+		Entry("should forbid migrating an existing cluster to vmos if not all worker pools are migrated", false, true, false, true),
+		Entry("should allow migrating an existing cluster to vmos if all worker pools are migrated", false, true, true, false),
 	)
+
+	Describe("#ValidateInfrastructureConfigAgainstReconciler", func() {
+		var (
+			infrastructureConfig *apisazure.InfrastructureConfig
+			path                 = field.NewPath("infrastructureConfig")
+		)
+
+		BeforeEach(func() {
+			infrastructureConfig = &apisazure.InfrastructureConfig{
+				ResourceLocks: &apisazure.ResourceLocksConfig{Enabled: true},
+			}
+		})
+
+		It("should allow flow-only features if the infrastructure is reconciled with flow", func() {
+			Expect(ValidateInfrastructureConfigAgainstReconciler(nil, infrastructureConfig, true, path)).To(BeEmpty())
+		})
+
+		It("should forbid resource locks if the infrastructure is not reconciled with flow", func() {
+			Expect(ValidateInfrastructureConfigAgainstReconciler(nil, infrastructureConfig, false, path)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("infrastructureConfig.resourceLocks.enabled"),
+			}))))
+		})
+
+		It("should forbid a managed identity if the infrastructure is not reconciled with flow", func() {
+			infrastructureConfig.ResourceLocks = nil
+			infrastructureConfig.Identity = &apisazure.IdentityConfig{Managed: true}
+
+			Expect(ValidateInfrastructureConfigAgainstReconciler(nil, infrastructureConfig, false, path)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("infrastructureConfig.identity.managed"),
+			}))))
+		})
+
+		It("should forbid application security groups and security rules if the infrastructure is not reconciled with flow", func() {
+			infrastructureConfig.ResourceLocks = nil
+			infrastructureConfig.Networks.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupConfig{{Name: "web"}}
+			infrastructureConfig.Networks.SecurityRules = []apisazure.SecurityRule{{Name: "allow-https"}}
+
+			Expect(ValidateInfrastructureConfigAgainstReconciler(nil, infrastructureConfig, false, path)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("infrastructureConfig.networks.applicationSecurityGroups"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("infrastructureConfig.networks.securityRules"),
+				})),
+			))
+		})
+
+		It("should forbid additional subnets if the infrastructure is not reconciled with flow", func() {
+			infrastructureConfig.ResourceLocks = nil
+			infrastructureConfig.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{{Name: "dmz"}}
+
+			Expect(ValidateInfrastructureConfigAgainstReconciler(nil, infrastructureConfig, false, path)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("infrastructureConfig.networks.additionalSubnets"),
+			}))))
+		})
+
+		It("should not reject features which were enabled before", func() {
+			Expect(ValidateInfrastructureConfigAgainstReconciler(infrastructureConfig.DeepCopy(), infrastructureConfig, false, path)).To(BeEmpty())
+		})
+	})
 })
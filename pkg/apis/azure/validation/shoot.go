@@ -6,6 +6,7 @@ package validation
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
@@ -16,6 +17,7 @@ import (
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 const maxDataVolumeCount = 64
@@ -58,7 +60,7 @@ func decodeNetworkConfig(network *runtime.RawExtension) (map[string]interface{},
 }
 
 // ValidateWorkers validates the workers of a Shoot.
-func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
+func ValidateWorkers(workers, oldWorkers []core.Worker, infra *api.InfrastructureConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	for i, worker := range workers {
@@ -68,6 +70,9 @@ func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fld
 			allErrs = append(allErrs, field.Required(path.Child("volume"), "must not be nil"))
 		} else {
 			allErrs = append(allErrs, validateVolume(worker.Volume, path.Child("volume"))...)
+			if worker.Volume.Type != nil && helper.HasProvisionedPerformance(*worker.Volume.Type) {
+				allErrs = append(allErrs, field.Invalid(path.Child("volume", "type"), *worker.Volume.Type, "cannot be used for the OS disk"))
+			}
 		}
 
 		if length := len(worker.DataVolumes); length > maxDataVolumeCount {
@@ -76,11 +81,18 @@ func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fld
 		for j, volume := range worker.DataVolumes {
 			dataVolPath := path.Child("dataVolumes").Index(j)
 			allErrs = append(allErrs, validateDataVolume(&volume, dataVolPath)...)
+			if volume.Type != nil && helper.HasProvisionedPerformance(*volume.Type) && !infra.Zoned {
+				allErrs = append(allErrs, field.Forbidden(dataVolPath.Child("type"), fmt.Sprintf("%s disks can only be used in zoned clusters", *volume.Type)))
+			}
 		}
 
 		// Zones validation
 		if infra.Zoned && len(worker.Zones) == 0 {
-			allErrs = append(allErrs, field.Required(path.Child("zones"), "at least one zone must be configured for zoned clusters"))
+			// While a non-zonal cluster is migrated to zones, its existing worker pools remain in the availability set
+			// until they are moved to zones.
+			if !isAvailabilitySetWorker(worker.Name, oldWorkers) {
+				allErrs = append(allErrs, field.Required(path.Child("zones"), "at least one zone must be configured for zoned clusters"))
+			}
 			continue
 		}
 
@@ -115,6 +127,55 @@ func ValidateWorkers(workers []core.Worker, infra *api.InfrastructureConfig, fld
 	return allErrs
 }
 
+// ValidateZoneMigration validates the migration of a non-zonal cluster to a zonal cluster. The migration is only
+// implemented by the flow reconciler, which also moves the load balancers to the standard SKU on request.
+func ValidateZoneMigration(oldInfra, newInfra *api.InfrastructureConfig, usesFlow bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !oldInfra.Zoned && newInfra.Zoned && !usesFlow {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zoned"), fmt.Sprintf("non-zonal clusters can only be migrated to zonal clusters if the infrastructure is reconciled with flow, use the %q annotation to enable it", azure.AnnotationKeyUseFlow)))
+	}
+
+	return allErrs
+}
+
+// ValidateLoadBalancerMigration validates the request to migrate the load balancers of a cluster, which was migrated to
+// zones, to the standard SKU. The load balancers can only be migrated by the flow reconciler once no worker pool is
+// placed in the availability set anymore.
+func ValidateLoadBalancerMigration(annotations map[string]string, infra *api.InfrastructureConfig, workers []core.Worker, usesFlow bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !helper.HasShootLoadBalancerMigrationAnnotation(annotations) {
+		return allErrs
+	}
+
+	annotationPath := fldPath.Key(azure.AnnotationKeyMigrateLoadBalancers)
+	if !usesFlow {
+		allErrs = append(allErrs, field.Forbidden(annotationPath, fmt.Sprintf("load balancers can only be migrated if the infrastructure is reconciled with flow, use the %q annotation to enable it", azure.AnnotationKeyUseFlow)))
+	}
+	if infra == nil || !infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(annotationPath, "load balancers can only be migrated for zonal clusters"))
+		return allErrs
+	}
+	for _, worker := range workers {
+		if len(worker.Zones) == 0 {
+			allErrs = append(allErrs, field.Forbidden(annotationPath, fmt.Sprintf("load balancers can only be migrated once all worker pools are placed in zones, worker pool %q is still placed in the availability set", worker.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// isAvailabilitySetWorker returns true if the worker pool with the given name already exists without zones.
+func isAvailabilitySetWorker(name string, oldWorkers []core.Worker) bool {
+	for _, oldWorker := range oldWorkers {
+		if oldWorker.Name == name {
+			return len(oldWorker.Zones) == 0
+		}
+	}
+	return false
+}
+
 // ValidateWorkersUpdate validates updates on `workers`.
 func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -112,15 +112,33 @@ var _ = Describe("Shoot validation", func() {
 				})
 
 				It("should pass because workers are configured correctly", func() {
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath(""))
 
 					Expect(errorList).To(BeEmpty())
 				})
 
+				It("should forbid data volumes with provisioned performance", func() {
+					workers[0].DataVolumes = []core.DataVolume{{
+						Name:       "data",
+						VolumeSize: "100Gi",
+						Type:       pointer.String("PremiumV2_LRS"),
+					}}
+
+					errorList := ValidateWorkers(workers, nil,
+						infraConfig, field.NewPath("workers"))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("workers[0].dataVolumes[0].type"),
+						})),
+					))
+				})
+
 				It("should forbid because zones are configured", func() {
 					workers[0].Zones = []string{"1", "2"}
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -140,7 +158,7 @@ var _ = Describe("Shoot validation", func() {
 				})
 
 				It("should pass because workers are configured correctly", func() {
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath(""))
 
 					Expect(errorList).To(BeEmpty())
@@ -149,7 +167,7 @@ var _ = Describe("Shoot validation", func() {
 				It("should forbid because volume is not configured", func() {
 					workers[1].Volume = nil
 
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -166,7 +184,7 @@ var _ = Describe("Shoot validation", func() {
 					workers[0].Volume.Encrypted = pointer.Bool(false)
 					workers[0].DataVolumes = []core.DataVolume{{Encrypted: pointer.Bool(true)}}
 
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -197,6 +215,30 @@ var _ = Describe("Shoot validation", func() {
 					))
 				})
 
+				It("should allow data volumes with provisioned performance", func() {
+					workers[0].DataVolumes = []core.DataVolume{{
+						Name:       "data",
+						VolumeSize: "100Gi",
+						Type:       pointer.String("UltraSSD_LRS"),
+					}}
+
+					Expect(ValidateWorkers(workers, nil, infraConfig, field.NewPath("workers"))).To(BeEmpty())
+				})
+
+				It("should forbid OS volume types which do not support OS disks", func() {
+					workers[0].Volume.Type = pointer.String("PremiumV2_LRS")
+
+					errorList := ValidateWorkers(workers, nil,
+						infraConfig, field.NewPath("workers"))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("workers[0].volume.type"),
+						})),
+					))
+				})
+
 				It("should forbid because of too many data volumes", func() {
 					for i := 0; i <= 64; i++ {
 						workers[0].DataVolumes = append(workers[0].DataVolumes, core.DataVolume{
@@ -206,7 +248,7 @@ var _ = Describe("Shoot validation", func() {
 						})
 					}
 
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -220,7 +262,7 @@ var _ = Describe("Shoot validation", func() {
 				It("should forbid because worker does not specify a zone", func() {
 					workers[0].Zones = nil
 
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -231,10 +273,35 @@ var _ = Describe("Shoot validation", func() {
 					))
 				})
 
+				It("should allow a worker pool without zones which remains in the availability set of a migrated cluster", func() {
+					oldWorkers := copyWorkers(workers)
+					oldWorkers[0].Zones = nil
+					workers[0].Zones = nil
+
+					errorList := ValidateWorkers(workers, oldWorkers, infraConfig, field.NewPath("workers"))
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid adding a new worker pool without zones to a migrated cluster", func() {
+					oldWorkers := copyWorkers(workers)
+					oldWorkers[0].Zones = nil
+					workers = append(workers, core.Worker{Name: "new-worker", Volume: workers[0].Volume})
+
+					errorList := ValidateWorkers(workers, oldWorkers, infraConfig, field.NewPath("workers"))
+
+					Expect(errorList).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("workers[2].zones"),
+						})),
+					))
+				})
+
 				It("should forbid because worker use zone twice", func() {
 					workers[0].Zones[1] = workers[0].Zones[0]
 
-					errorList := ValidateWorkers(workers,
+					errorList := ValidateWorkers(workers, nil,
 						infraConfig, field.NewPath("workers"))
 
 					Expect(errorList).To(ConsistOf(
@@ -264,7 +331,7 @@ var _ = Describe("Shoot validation", func() {
 
 					It("should forbid using zones not configured in infrastructure", func() {
 						workers[0].Zones[0] = "non-existent"
-						errorList := ValidateWorkers(workers,
+						errorList := ValidateWorkers(workers, nil,
 							infraConfig, field.NewPath("workers"))
 
 						Expect(errorList).To(ConsistOf(
@@ -276,7 +343,7 @@ var _ = Describe("Shoot validation", func() {
 					})
 
 					It("should allow zones when configured in infrastructure", func() {
-						errorList := ValidateWorkers(workers,
+						errorList := ValidateWorkers(workers, nil,
 							infraConfig, field.NewPath("workers"))
 
 						Expect(errorList).To(BeEmpty())
@@ -363,6 +430,114 @@ var _ = Describe("Shoot validation", func() {
 				})
 			})
 		})
+
+		Describe("#ValidateZoneMigration", func() {
+			var (
+				oldInfraConfig *api.InfrastructureConfig
+				infraConfig    *api.InfrastructureConfig
+				fldPath        = field.NewPath("spec", "provider", "infrastructureConfig")
+			)
+
+			BeforeEach(func() {
+				oldInfraConfig = &api.InfrastructureConfig{
+					Networks: api.NetworkConfig{
+						Workers: pointer.String("10.250.0.0/16"),
+					},
+				}
+				infraConfig = oldInfraConfig.DeepCopy()
+				infraConfig.Zoned = true
+			})
+
+			It("should allow flipping a non-zonal cluster to zoned if it is reconciled with flow", func() {
+				Expect(ValidateZoneMigration(oldInfraConfig, infraConfig, true, fldPath)).To(BeEmpty())
+			})
+
+			It("should allow dedicated subnets per zone and NAT gateways during the migration", func() {
+				oldInfraConfig.Zoned = true
+				infraConfig.Networks = api.NetworkConfig{
+					Zones: []api.Zone{
+						{Name: 1, CIDR: "10.250.0.0/16", NatGateway: &api.ZonedNatGatewayConfig{Enabled: true}},
+						{Name: 2, CIDR: "10.251.0.0/16", NatGateway: &api.ZonedNatGatewayConfig{Enabled: true}},
+					},
+				}
+
+				Expect(ValidateZoneMigration(oldInfraConfig, infraConfig, true, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid flipping a non-zonal cluster to zoned if it is not reconciled with flow", func() {
+				Expect(ValidateZoneMigration(oldInfraConfig, infraConfig, false, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.provider.infrastructureConfig.zoned"),
+					})),
+				))
+			})
+
+			It("should not validate clusters which are already zonal", func() {
+				oldInfraConfig.Zoned = true
+
+				Expect(ValidateZoneMigration(oldInfraConfig, infraConfig, false, fldPath)).To(BeEmpty())
+			})
+		})
+
+		Describe("#ValidateLoadBalancerMigration", func() {
+			var (
+				annotations map[string]string
+				infraConfig *api.InfrastructureConfig
+				workers     []core.Worker
+				fldPath     = field.NewPath("metadata", "annotations")
+			)
+
+			BeforeEach(func() {
+				annotations = map[string]string{"azure.provider.extensions.gardener.cloud/migrate-load-balancers": "true"}
+				infraConfig = &api.InfrastructureConfig{Zoned: true}
+				workers = []core.Worker{
+					{Name: "worker-1", Zones: []string{"1"}},
+					{Name: "worker-2", Zones: []string{"2"}},
+				}
+			})
+
+			It("should allow the migration once all worker pools are placed in zones", func() {
+				Expect(ValidateLoadBalancerMigration(annotations, infraConfig, workers, true, fldPath)).To(BeEmpty())
+			})
+
+			It("should not validate shoots which do not request the migration", func() {
+				infraConfig.Zoned = false
+
+				Expect(ValidateLoadBalancerMigration(nil, infraConfig, workers, false, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid the migration if the infrastructure is not reconciled with flow", func() {
+				Expect(ValidateLoadBalancerMigration(annotations, infraConfig, workers, false, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("metadata.annotations[azure.provider.extensions.gardener.cloud/migrate-load-balancers]"),
+					})),
+				))
+			})
+
+			It("should forbid the migration for non-zonal clusters", func() {
+				infraConfig.Zoned = false
+
+				Expect(ValidateLoadBalancerMigration(annotations, infraConfig, workers, true, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Detail": ContainSubstring("zonal clusters"),
+					})),
+				))
+			})
+
+			It("should forbid the migration as long as a worker pool is placed in the availability set", func() {
+				workers[1].Zones = nil
+
+				Expect(ValidateLoadBalancerMigration(annotations, infraConfig, workers, true, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Detail": ContainSubstring(`"worker-2"`),
+					})),
+				))
+			})
+		})
 	})
 })
 
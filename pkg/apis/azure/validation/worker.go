@@ -5,14 +5,38 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/gardener/gardener/pkg/apis/core"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	apiazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+)
+
+const (
+	diskEncryptionSetResourceType = "Microsoft.Compute/diskEncryptionSets"
+	hostGroupResourceType         = "Microsoft.Compute/hostGroups"
+	hostResourceType              = "Microsoft.Compute/hostGroups/hosts"
+	capacityReservationGroupType  = "Microsoft.Compute/capacityReservationGroups"
+
+	// Azure allows up to 256 IP configurations per network interface, including the primary one.
+	maxSecondaryIPConfigurations = 255
 )
 
 // ValidateWorkerConfig validates a WorkerConfig object.
@@ -21,11 +45,464 @@ func ValidateWorkerConfig(workerConfig *apiazure.WorkerConfig, fldPath *field.Pa
 
 	if workerConfig != nil {
 		allErrs = append(allErrs, validateNodeTemplate(workerConfig.NodeTemplate, fldPath)...)
+		allErrs = append(allErrs, validateDiskEncryption(workerConfig.DiskEncryption, fldPath.Child("diskEncryption"))...)
+		allErrs = append(allErrs, validateSpotConfig(workerConfig.Spot, fldPath.Child("spot"))...)
+		allErrs = append(allErrs, validateOSDiskConfig(workerConfig.OSDisk, fldPath.Child("osDisk"))...)
+		allErrs = append(allErrs, validateDataVolumeConfigs(workerConfig.DataVolumes, fldPath.Child("dataVolumes"))...)
+		allErrs = append(allErrs, validateSecurityConfig(workerConfig.Security, fldPath.Child("security"))...)
+		allErrs = append(allErrs, validateDedicatedHostConfig(workerConfig.DedicatedHost, fldPath.Child("dedicatedHost"))...)
+		allErrs = append(allErrs, validateCapacityReservationConfig(workerConfig.CapacityReservation, fldPath.Child("capacityReservation"))...)
+		for i, ref := range workerConfig.ApplicationSecurityGroups {
+			allErrs = append(allErrs, validateApplicationSecurityGroupReference(ref, nil, fldPath.Child("applicationSecurityGroups").Index(i))...)
+		}
+		allErrs = append(allErrs, validateSecondaryIPConfigurations(workerConfig.SecondaryIPConfigurations, fldPath.Child("secondaryIPConfigurations"))...)
+		for i, nic := range workerConfig.AdditionalNetworkInterfaces {
+			allErrs = append(allErrs, validateSecondaryIPConfigurations(nic.SecondaryIPConfigurations, fldPath.Child("additionalNetworkInterfaces").Index(i).Child("secondaryIPConfigurations"))...)
+		}
+		allErrs = append(allErrs, validateVMExtensions(workerConfig.Extensions, fldPath.Child("extensions"))...)
+		allErrs = append(allErrs, validateBootDiagnostics(workerConfig.BootDiagnostics, fldPath.Child("bootDiagnostics"))...)
+
+		// evicted machines cannot be deallocated as the content of the ephemeral OS disk would be lost.
+		if isEphemeralOSDisk(workerConfig) && workerConfig.Spot != nil && workerConfig.Spot.EvictionPolicy != nil && *workerConfig.Spot.EvictionPolicy == apiazure.SpotEvictionPolicyDeallocate {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot", "evictionPolicy"), "spot machines with an ephemeral OS disk must use the Delete eviction policy"))
+		}
+
+		if isEphemeralOSDisk(workerConfig) && isConfidentialVM(workerConfig) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("osDisk", "ephemeralPlacement"), "confidential VMs cannot use an ephemeral OS disk"))
+		}
+
+		if workerConfig.DedicatedHost != nil && workerConfig.Spot != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), "spot machines cannot be placed on dedicated hosts"))
+		}
+
+		if workerConfig.CapacityReservation != nil {
+			if workerConfig.Spot != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), "spot machines cannot use capacity reservations"))
+			}
+			if workerConfig.DedicatedHost != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacityReservation"), "machines on dedicated hosts cannot use capacity reservations"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstReconciler validates that the WorkerConfig does not reference additional subnets if the
+// shoot's infrastructure is not reconciled with flow, as these subnets are only created by flow.
+func ValidateWorkerConfigAgainstReconciler(workerConfig *apiazure.WorkerConfig, usesFlow bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || usesFlow {
+		return allErrs
+	}
+
+	detail := fmt.Sprintf("additional subnets are only supported if the infrastructure is reconciled with flow, use the %q annotation to enable it", azure.AnnotationKeyUseFlow)
+	if workerConfig.SubnetName != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnetName"), detail))
+	}
+	for i, nic := range workerConfig.AdditionalNetworkInterfaces {
+		if nic.SubnetName != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalNetworkInterfaces").Index(i).Child("subnetName"), detail))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstInfrastructure validates a WorkerConfig object against the InfrastructureConfig of the shoot.
+func ValidateWorkerConfigAgainstInfrastructure(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig, hasVmoAlphaAnnotation bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil || infra == nil {
+		return allErrs
+	}
+
+	// machines of migrated worker pools are placed in a VMO of their own, although the cluster uses an availability set.
+	vmoConfigured := helper.IsVmoConfigured(infra, hasVmoAlphaAnnotation) || helper.IsWorkerPoolMigratedToVmo(workerConfig)
+	if workerConfig.OrchestrationMode != nil {
+		if *workerConfig.OrchestrationMode != apiazure.OrchestrationModeVMSSFlex {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("orchestrationMode"), *workerConfig.OrchestrationMode, []string{string(apiazure.OrchestrationModeVMSSFlex)}))
+		} else if infra.Zoned {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("orchestrationMode"), "the orchestration mode can only be set for worker pools of non-zonal clusters"))
+		}
+	}
+
+	// machines of non-zonal clusters without VMO are placed in an availability set, which cannot contain spot machines.
+	if workerConfig.Spot != nil && !infra.Zoned && !vmoConfigured {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), "spot machines are not supported for clusters using an availability set"))
+	}
+
+	// machines of non-zonal clusters are placed in an availability set or VMO, which are not part of a proximity placement group.
+	if workerConfig.ProximityPlacementGroup != nil && !infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("proximityPlacementGroup"), "proximity placement groups are only supported for zoned clusters, as the machines of non-zonal clusters are placed in an availability set or VMO which cannot be combined with a proximity placement group of the worker pool"))
+	}
+
+	// the same applies to dedicated host groups, which are pinned to a zone for zoned clusters.
+	if workerConfig.DedicatedHost != nil && !infra.Zoned {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dedicatedHost"), "dedicated hosts are only supported for zoned clusters"))
+	}
+
+	// capacity reservations do not support availability sets.
+	if workerConfig.CapacityReservation != nil && !infra.Zoned && !vmoConfigured {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacityReservation"), "capacity reservations are not supported for clusters using an availability set"))
+	}
+
+	subnetNames := additionalSubnetNames(infra)
+	if workerConfig.SubnetName != nil && !subnetNames.Has(*workerConfig.SubnetName) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("subnetName"), *workerConfig.SubnetName))
+	}
+	for i, nic := range workerConfig.AdditionalNetworkInterfaces {
+		if nic.SubnetName != nil && !subnetNames.Has(*nic.SubnetName) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("additionalNetworkInterfaces").Index(i).Child("subnetName"), *nic.SubnetName))
+		}
+	}
+
+	// application security groups referenced by name are managed as part of the infrastructure.
+	asgNames := applicationSecurityGroupNames(infra)
+	for i, ref := range workerConfig.ApplicationSecurityGroups {
+		if ref.Name != nil && !asgNames.Has(*ref.Name) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("applicationSecurityGroups").Index(i).Child("name"), *ref.Name))
+		}
+	}
+
+	diskEncryptionSetID, encryptionAtHost := effectiveDiskEncryption(workerConfig, infra)
+
+	if isEphemeralOSDisk(workerConfig) && diskEncryptionSetID {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("osDisk", "ephemeralPlacement"), "ephemeral OS disks cannot be encrypted with a disk encryption set, use encryption at host instead"))
+	}
+
+	if isConfidentialVM(workerConfig) {
+		if encryptionAtHost {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("security", "type"), "confidential VMs do not support encryption at host"))
+		}
+		// the disk encryption set of a confidential VM encrypts the OS disk together with the VM guest state.
+		if diskEncryptionSetID && (workerConfig.Security.OSDiskEncryptionType == nil || *workerConfig.Security.OSDiskEncryptionType != apiazure.SecurityEncryptionTypeDiskWithVMGuestState) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("security", "osDiskEncryptionType"), fmt.Sprintf("confidential VMs can only use a disk encryption set with the %s OS disk encryption type", apiazure.SecurityEncryptionTypeDiskWithVMGuestState)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAvailabilitySetWorkerConfig validates the WorkerConfig of a worker pool without zones of a zoned cluster.
+// Such worker pools remain in the availability set of the cluster until they are moved to zones.
+func ValidateAvailabilitySetWorkerConfig(workerConfig *apiazure.WorkerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	const detail = "is not supported for worker pools in the availability set, move the worker pool to zones first"
+	if workerConfig.Spot != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("spot"), "spot machines are not supported for worker pools in the availability set, move the worker pool to zones first"))
+	}
+	if workerConfig.ProximityPlacementGroup != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("proximityPlacementGroup"), "a proximity placement group "+detail))
+	}
+	if workerConfig.DedicatedHost != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dedicatedHost"), "a dedicated host "+detail))
+	}
+	if workerConfig.CapacityReservation != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("capacityReservation"), "a capacity reservation "+detail))
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstResources validates that the secrets referenced by a WorkerConfig object are part of the
+// resources of the shoot.
+func ValidateWorkerConfigAgainstResources(workerConfig *apiazure.WorkerConfig, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	for i, extension := range workerConfig.Extensions {
+		if extension.ProtectedSettingsSecretRef == nil {
+			continue
+		}
+		refPath := fldPath.Child("extensions").Index(i).Child("protectedSettingsSecretRef")
+		resource := findResource(resources, *extension.ProtectedSettingsSecretRef)
+		if resource == nil {
+			allErrs = append(allErrs, field.NotFound(refPath, *extension.ProtectedSettingsSecretRef))
+		} else if resource.ResourceRef.Kind != "Secret" {
+			allErrs = append(allErrs, field.Invalid(refPath, *extension.ProtectedSettingsSecretRef, "must reference a Secret"))
+		}
+	}
+
+	return allErrs
+}
+
+func findResource(resources []core.NamedResourceReference, name string) *core.NamedResourceReference {
+	for i := range resources {
+		if resources[i].Name == name {
+			return &resources[i]
+		}
+	}
+	return nil
+}
+
+// ValidateWorkerConfigAgainstCloudProfile validates a WorkerConfig object against the capabilities of the worker's
+// machine type and machine image declared in the CloudProfileConfig.
+func ValidateWorkerConfigAgainstCloudProfile(workerConfig *apiazure.WorkerConfig, worker core.Worker, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if isEphemeralOSDisk(workerConfig) && worker.Volume != nil {
+		allErrs = append(allErrs, validateEphemeralOSDiskAgainstMachineType(*workerConfig.OSDisk.EphemeralPlacement, worker, findMachineType(cloudProfileConfig, worker.Machine.Type), fldPath.Child("osDisk", "ephemeralPlacement"))...)
+	}
+
+	if workerConfig != nil && len(workerConfig.AdditionalNetworkInterfaces) > 0 {
+		allErrs = append(allErrs, validateNetworkInterfacesAgainstMachineType(workerConfig.AdditionalNetworkInterfaces, worker, findMachineType(cloudProfileConfig, worker.Machine.Type), fldPath.Child("additionalNetworkInterfaces"))...)
+	}
+
+	if workerConfig != nil && workerConfig.Security != nil {
+		securityType := helper.SecurityType(workerConfig.Security)
+		if machineType := findMachineType(cloudProfileConfig, worker.Machine.Type); machineType != nil && !helper.SupportsSecurityType(machineType.SecurityTypes, securityType) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("security", "type"), fmt.Sprintf("machine type %q does not support the security type %s", worker.Machine.Type, securityType)))
+		}
+		if version := findImageVersion(cloudProfileConfig, worker.Machine.Image, worker.Machine.Architecture); version != nil && !helper.SupportsSecurityType(version.SecurityTypes, securityType) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("security", "type"), fmt.Sprintf("machine image %q in version %q does not support the security type %s", worker.Machine.Image.Name, worker.Machine.Image.Version, securityType)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateEphemeralOSDiskAgainstMachineType(placement apiazure.EphemeralOSDiskPlacement, worker core.Worker, machineType *apiazure.MachineType, placementPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if machineType != nil && machineType.EphemeralOSDisk != nil && !*machineType.EphemeralOSDisk {
+		return append(allErrs, field.Forbidden(placementPath, fmt.Sprintf("machine type %q does not support ephemeral OS disks", worker.Machine.Type)))
+	}
+
+	var localDiskSize *int32
+	if machineType != nil {
+		switch placement {
+		case apiazure.EphemeralOSDiskPlacementCacheDisk:
+			localDiskSize = machineType.CacheDiskSizeGB
+		case apiazure.EphemeralOSDiskPlacementResourceDisk:
+			localDiskSize = machineType.ResourceDiskSizeGB
+		}
+	}
+	if localDiskSize == nil {
+		return append(allErrs, field.Forbidden(placementPath, fmt.Sprintf("machine type %q does not declare a %s size in the cloud profile", worker.Machine.Type, placement)))
+	}
+
+	volumeSize, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+	if err != nil {
+		// the volume size is validated with the worker.
+		return allErrs
+	}
+	// round up to full GiB like the OS disk which is created for the volume.
+	if sizeGB := (volumeSize.Value() + 1<<30 - 1) >> 30; sizeGB > int64(*localDiskSize) {
+		allErrs = append(allErrs, field.Invalid(placementPath, placement, fmt.Sprintf("the %s of machine type %q (%dGi) is smaller than the OS disk (%dGi)", placement, worker.Machine.Type, *localDiskSize, sizeGB)))
+	}
+
+	return allErrs
+}
+
+func validateNetworkInterfacesAgainstMachineType(nics []apiazure.NetworkInterface, worker core.Worker, machineType *apiazure.MachineType, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if machineType == nil {
+		return allErrs
+	}
+
+	// the primary network interface counts towards the maximum as well.
+	if maxNICs := machineType.MaxNetworkInterfaces; maxNICs != nil && int32(len(nics))+1 > *maxNICs {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(nics), int(*maxNICs-1)))
+	}
+
+	if machineType.AcceleratedNetworking == nil || !*machineType.AcceleratedNetworking {
+		for i, nic := range nics {
+			if nic.AcceleratedNetworking != nil && *nic.AcceleratedNetworking {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("acceleratedNetworking"), fmt.Sprintf("machine type %q does not support accelerated networking", worker.Machine.Type)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerConfigAgainstWorker validates a WorkerConfig object against the zones and data volumes of the worker.
+func ValidateWorkerConfigAgainstWorker(workerConfig *apiazure.WorkerConfig, worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	// a dedicated host group only provides hosts in a single zone.
+	if workerConfig.DedicatedHost != nil && len(worker.Zones) != 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("dedicatedHost"), "worker pools placed on dedicated hosts must use exactly one zone"))
+	}
+
+	volumeTypes := make(map[string]*string, len(worker.DataVolumes))
+	for _, volume := range worker.DataVolumes {
+		volumeTypes[volume.Name] = volume.Type
+	}
+
+	for i, dataVolume := range workerConfig.DataVolumes {
+		idxPath := fldPath.Child("dataVolumes").Index(i)
+
+		volumeType, ok := volumeTypes[dataVolume.Name]
+		if !ok {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), dataVolume.Name))
+			continue
+		}
+
+		// only disks with provisioned performance allow to configure IOPS and throughput and none of them supports host caching.
+		if volumeType != nil && helper.HasProvisionedPerformance(*volumeType) {
+			if dataVolume.Caching != nil && *dataVolume.Caching != apiazure.CachingTypeNone {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("caching"), fmt.Sprintf("%s disks do not support host caching", *volumeType)))
+			}
+			continue
+		}
+		if dataVolume.IOPS != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("iops"), fmt.Sprintf("can only be set for volumes of type %s or %s", azure.DiskTypePremiumV2LRS, azure.DiskTypeUltraSSDLRS)))
+		}
+		if dataVolume.ThroughputMBps != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("throughputMBps"), fmt.Sprintf("can only be set for volumes of type %s or %s", azure.DiskTypePremiumV2LRS, azure.DiskTypeUltraSSDLRS)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateWorkerAgainstCloudProfile validates the volumes, architecture and zones of a worker against the capabilities of
+// its machine type declared in the CloudProfileConfig.
+func ValidateWorkerAgainstCloudProfile(worker core.Worker, region string, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	machineType := findMachineType(cloudProfileConfig, worker.Machine.Type)
+	if machineType == nil {
+		return allErrs
+	}
+
+	validateVolumeType := func(volumeType *string, fldPath *field.Path) {
+		if volumeType == nil {
+			return
+		}
+		switch {
+		case *volumeType == azure.DiskTypeUltraSSDLRS:
+			if machineType.UltraSSD == nil || !*machineType.UltraSSD {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("machine type %q does not support %s disks", worker.Machine.Type, *volumeType)))
+			}
+		case helper.IsPremiumDiskType(*volumeType):
+			// machine types are assumed to support premium storage unless stated otherwise.
+			if machineType.PremiumIO != nil && !*machineType.PremiumIO {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("machine type %q does not support %s disks", worker.Machine.Type, *volumeType)))
+			}
+		}
+	}
+
+	if worker.Volume != nil {
+		validateVolumeType(worker.Volume.Type, fldPath.Child("volume", "type"))
+	}
+	for i, volume := range worker.DataVolumes {
+		validateVolumeType(volume.Type, fldPath.Child("dataVolumes").Index(i).Child("type"))
+	}
+
+	if maxDataDisks := machineType.MaxDataDisks; maxDataDisks != nil && len(worker.DataVolumes) > int(*maxDataDisks) {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("dataVolumes"), len(worker.DataVolumes), int(*maxDataDisks)))
+	}
+
+	if architecture := pointer.StringDeref(worker.Machine.Architecture, v1beta1constants.ArchitectureAMD64); len(machineType.Architectures) > 0 && !slices.Contains(machineType.Architectures, architecture) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("machine", "architecture"), architecture, machineType.Architectures))
+	}
+
+	for _, zones := range machineType.Zones {
+		if zones.Region != region {
+			continue
+		}
+		for i, zone := range worker.Zones {
+			if !slices.Contains(zones.Names, zone) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("zones").Index(i), zone, zones.Names))
+			}
+		}
 	}
 
 	return allErrs
 }
 
+// ValidateWindowsWorkers validates the worker pools which use Windows machine images according to the CloudProfileConfig.
+func ValidateWindowsWorkers(workers []core.Worker, cloudProfileConfig *apiazure.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, worker := range workers {
+		if !isWindowsImage(cloudProfileConfig, worker.Machine.Image, worker.Machine.Architecture) {
+			continue
+		}
+		// the system components of the shoot, e.g. the network and DNS components, only run on Linux nodes.
+		if worker.SystemComponents == nil || worker.SystemComponents.Allow {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("systemComponents", "allow"), "worker pools with windows machine images cannot host system components"))
+		}
+	}
+
+	return allErrs
+}
+
+func findMachineType(cloudProfileConfig *apiazure.CloudProfileConfig, name string) *apiazure.MachineType {
+	if cloudProfileConfig == nil {
+		return nil
+	}
+	for i := range cloudProfileConfig.MachineTypes {
+		if cloudProfileConfig.MachineTypes[i].Name == name {
+			return &cloudProfileConfig.MachineTypes[i]
+		}
+	}
+	return nil
+}
+
+func isWindowsImage(cloudProfileConfig *apiazure.CloudProfileConfig, image *core.ShootMachineImage, architecture *string) bool {
+	version := findImageVersion(cloudProfileConfig, image, architecture)
+	return version != nil && version.OperatingSystem != nil && *version.OperatingSystem == apiazure.OperatingSystemWindows
+}
+
+func findImageVersion(cloudProfileConfig *apiazure.CloudProfileConfig, image *core.ShootMachineImage, architecture *string) *apiazure.MachineImageVersion {
+	if cloudProfileConfig == nil || image == nil {
+		return nil
+	}
+	arch := pointer.StringDeref(architecture, v1beta1constants.ArchitectureAMD64)
+	for _, machineImage := range cloudProfileConfig.MachineImages {
+		if machineImage.Name != image.Name {
+			continue
+		}
+		for i, version := range machineImage.Versions {
+			if version.Version == image.Version && pointer.StringDeref(version.Architecture, v1beta1constants.ArchitectureAMD64) == arch {
+				return &machineImage.Versions[i]
+			}
+		}
+	}
+	return nil
+}
+
+func effectiveDiskEncryption(workerConfig *apiazure.WorkerConfig, infra *apiazure.InfrastructureConfig) (diskEncryptionSetID, encryptionAtHost bool) {
+	for _, diskEncryption := range []*apiazure.DiskEncryption{infra.DiskEncryption, workerConfig.DiskEncryption} {
+		if diskEncryption == nil {
+			continue
+		}
+		if diskEncryption.DiskEncryptionSetID != nil {
+			diskEncryptionSetID = true
+		}
+		if diskEncryption.EncryptionAtHost != nil {
+			encryptionAtHost = *diskEncryption.EncryptionAtHost
+		}
+	}
+	return
+}
+
+func isConfidentialVM(workerConfig *apiazure.WorkerConfig) bool {
+	return workerConfig != nil && workerConfig.Security != nil && workerConfig.Security.Type != nil && *workerConfig.Security.Type == apiazure.SecurityTypeConfidentialVM
+}
+
+func isEphemeralOSDisk(workerConfig *apiazure.WorkerConfig) bool {
+	return workerConfig != nil && workerConfig.OSDisk != nil && workerConfig.OSDisk.EphemeralPlacement != nil
+}
+
 func validateNodeTemplate(nodeTemplate *extensionsv1alpha1.NodeTemplate, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -44,6 +521,257 @@ func validateNodeTemplate(nodeTemplate *extensionsv1alpha1.NodeTemplate, fldPath
 	return allErrs
 }
 
+func validateDiskEncryption(diskEncryption *apiazure.DiskEncryption, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if diskEncryption == nil || diskEncryption.DiskEncryptionSetID == nil {
+		return allErrs
+	}
+
+	idPath := fldPath.Child("diskEncryptionSetID")
+	id, err := arm.ParseResourceID(*diskEncryption.DiskEncryptionSetID)
+	if err != nil {
+		return append(allErrs, field.Invalid(idPath, *diskEncryption.DiskEncryptionSetID, fmt.Sprintf("must be a valid resource id: %v", err)))
+	}
+	if !strings.EqualFold(id.ResourceType.String(), diskEncryptionSetResourceType) {
+		allErrs = append(allErrs, field.Invalid(idPath, *diskEncryption.DiskEncryptionSetID, fmt.Sprintf("must be the id of a resource of type %s", diskEncryptionSetResourceType)))
+	}
+
+	return allErrs
+}
+
+func validateBootDiagnostics(bootDiagnostics *apiazure.BootDiagnostics, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if bootDiagnostics == nil || bootDiagnostics.StorageURI == nil {
+		return allErrs
+	}
+
+	uriPath := fldPath.Child("storageURI")
+	if !bootDiagnostics.Enabled {
+		return append(allErrs, field.Forbidden(uriPath, "a storage account can only be set if boot diagnostics are enabled"))
+	}
+	if u, err := url.Parse(*bootDiagnostics.StorageURI); err != nil || u.Scheme != "https" || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(uriPath, *bootDiagnostics.StorageURI, "must be the https blob endpoint of a storage account"))
+	}
+
+	return allErrs
+}
+
+func validateDedicatedHostConfig(dedicatedHost *apiazure.DedicatedHostConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dedicatedHost == nil {
+		return allErrs
+	}
+
+	hostGroupIDPath := fldPath.Child("hostGroupID")
+	hostGroupID, err := arm.ParseResourceID(dedicatedHost.HostGroupID)
+	if err != nil {
+		return append(allErrs, field.Invalid(hostGroupIDPath, dedicatedHost.HostGroupID, fmt.Sprintf("must be a valid resource id: %v", err)))
+	}
+	if !strings.EqualFold(hostGroupID.ResourceType.String(), hostGroupResourceType) {
+		allErrs = append(allErrs, field.Invalid(hostGroupIDPath, dedicatedHost.HostGroupID, fmt.Sprintf("must be the id of a resource of type %s", hostGroupResourceType)))
+	}
+
+	if dedicatedHost.HostID == nil {
+		return allErrs
+	}
+
+	hostIDPath := fldPath.Child("hostID")
+	hostID, err := arm.ParseResourceID(*dedicatedHost.HostID)
+	if err != nil {
+		return append(allErrs, field.Invalid(hostIDPath, *dedicatedHost.HostID, fmt.Sprintf("must be a valid resource id: %v", err)))
+	}
+	if !strings.EqualFold(hostID.ResourceType.String(), hostResourceType) {
+		allErrs = append(allErrs, field.Invalid(hostIDPath, *dedicatedHost.HostID, fmt.Sprintf("must be the id of a resource of type %s", hostResourceType)))
+	} else if !strings.EqualFold(hostID.Parent.String(), hostGroupID.String()) {
+		allErrs = append(allErrs, field.Invalid(hostIDPath, *dedicatedHost.HostID, "must be the id of a host of the dedicated host group"))
+	}
+
+	return allErrs
+}
+
+func validateCapacityReservationConfig(capacityReservation *apiazure.CapacityReservationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if capacityReservation == nil {
+		return allErrs
+	}
+
+	idPath := fldPath.Child("capacityReservationGroupID")
+	id, err := arm.ParseResourceID(capacityReservation.CapacityReservationGroupID)
+	if err != nil {
+		return append(allErrs, field.Invalid(idPath, capacityReservation.CapacityReservationGroupID, fmt.Sprintf("must be a valid resource id: %v", err)))
+	}
+	if !strings.EqualFold(id.ResourceType.String(), capacityReservationGroupType) {
+		allErrs = append(allErrs, field.Invalid(idPath, capacityReservation.CapacityReservationGroupID, fmt.Sprintf("must be the id of a resource of type %s", capacityReservationGroupType)))
+	}
+
+	return allErrs
+}
+
+func validateSecondaryIPConfigurations(count *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if count != nil && (*count < 0 || *count > maxSecondaryIPConfigurations) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *count, fmt.Sprintf("must be between 0 and %d", maxSecondaryIPConfigurations)))
+	}
+
+	return allErrs
+}
+
+func validateVMExtensions(extensions []apiazure.VMExtension, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, extension := range extensions {
+		idxPath := fldPath.Index(i)
+
+		// the name is part of the key of the protected settings in the secret of the machine class.
+		if len(extension.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(extension.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), extension.Name))
+		} else {
+			for _, msg := range validation.IsConfigMapKey(extension.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), extension.Name, msg))
+			}
+		}
+		names.Insert(extension.Name)
+
+		if len(extension.Publisher) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("publisher"), "must provide the publisher of the extension handler"))
+		}
+		if len(extension.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must provide the type of the extension handler"))
+		}
+		if len(extension.TypeHandlerVersion) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("typeHandlerVersion"), "must provide the version of the extension handler"))
+		}
+
+		if extension.Settings != nil {
+			var settings map[string]interface{}
+			if err := json.Unmarshal(extension.Settings.Raw, &settings); err != nil || settings == nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("settings"), string(extension.Settings.Raw), "must be a JSON object"))
+			}
+		}
+
+		if extension.ProtectedSettingsSecretRef != nil && len(*extension.ProtectedSettingsSecretRef) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("protectedSettingsSecretRef"), "must provide the name of a resource of the shoot"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSpotConfig(spot *apiazure.SpotConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spot == nil {
+		return allErrs
+	}
+
+	if spot.Priority != nil && *spot.Priority != apiazure.VMPrioritySpot && *spot.Priority != apiazure.VMPriorityLow {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("priority"), *spot.Priority, []string{string(apiazure.VMPrioritySpot), string(apiazure.VMPriorityLow)}))
+	}
+	if spot.EvictionPolicy != nil && *spot.EvictionPolicy != apiazure.SpotEvictionPolicyDelete && *spot.EvictionPolicy != apiazure.SpotEvictionPolicyDeallocate {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("evictionPolicy"), *spot.EvictionPolicy, []string{string(apiazure.SpotEvictionPolicyDelete), string(apiazure.SpotEvictionPolicyDeallocate)}))
+	}
+	if spot.MaxPrice != nil {
+		allErrs = append(allErrs, validateSpotMaxPrice(*spot.MaxPrice, fldPath.Child("maxPrice"))...)
+	}
+
+	return allErrs
+}
+
+func validateSpotMaxPrice(maxPrice string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	price, err := strconv.ParseFloat(maxPrice, 64)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, maxPrice, "must be a decimal number"))
+	}
+	if price != -1 && price <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, maxPrice, "must be -1 or greater than 0"))
+	}
+	// Azure accepts up to five decimal places.
+	if scaled := price * 1e5; math.Abs(scaled-math.Round(scaled)) > 1e-6 {
+		allErrs = append(allErrs, field.Invalid(fldPath, maxPrice, "must not have more than five decimal places"))
+	}
+
+	return allErrs
+}
+
+func validateOSDiskConfig(osDisk *apiazure.OSDiskConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if osDisk == nil || osDisk.EphemeralPlacement == nil {
+		return allErrs
+	}
+
+	if placement := *osDisk.EphemeralPlacement; placement != apiazure.EphemeralOSDiskPlacementCacheDisk && placement != apiazure.EphemeralOSDiskPlacementResourceDisk {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("ephemeralPlacement"), placement, []string{string(apiazure.EphemeralOSDiskPlacementCacheDisk), string(apiazure.EphemeralOSDiskPlacementResourceDisk)}))
+	}
+
+	return allErrs
+}
+
+func validateSecurityConfig(security *apiazure.SecurityConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if security == nil {
+		return allErrs
+	}
+
+	if security.Type != nil && *security.Type != apiazure.SecurityTypeTrustedLaunch && *security.Type != apiazure.SecurityTypeConfidentialVM {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), *security.Type, []string{string(apiazure.SecurityTypeTrustedLaunch), string(apiazure.SecurityTypeConfidentialVM)}))
+	}
+
+	confidentialVM := security.Type != nil && *security.Type == apiazure.SecurityTypeConfidentialVM
+	if confidentialVM && security.VTPM != nil && !*security.VTPM {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("vTPM"), "confidential VMs require a vTPM"))
+	}
+
+	if encryptionType := security.OSDiskEncryptionType; encryptionType != nil {
+		if !confidentialVM {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("osDiskEncryptionType"), "can only be set for confidential VMs"))
+		} else if *encryptionType != apiazure.SecurityEncryptionTypeVMGuestStateOnly && *encryptionType != apiazure.SecurityEncryptionTypeDiskWithVMGuestState {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("osDiskEncryptionType"), *encryptionType, []string{string(apiazure.SecurityEncryptionTypeVMGuestStateOnly), string(apiazure.SecurityEncryptionTypeDiskWithVMGuestState)}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateDataVolumeConfigs(dataVolumes []apiazure.DataVolume, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := make(map[string]struct{}, len(dataVolumes))
+	for i, dataVolume := range dataVolumes {
+		idxPath := fldPath.Index(i)
+
+		if dataVolume.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify the name of a data volume"))
+		} else if _, ok := names[dataVolume.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), dataVolume.Name))
+		}
+		names[dataVolume.Name] = struct{}{}
+
+		if caching := dataVolume.Caching; caching != nil && *caching != apiazure.CachingTypeNone && *caching != apiazure.CachingTypeReadOnly && *caching != apiazure.CachingTypeReadWrite {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("caching"), *caching, []string{string(apiazure.CachingTypeNone), string(apiazure.CachingTypeReadOnly), string(apiazure.CachingTypeReadWrite)}))
+		}
+		if dataVolume.IOPS != nil && *dataVolume.IOPS <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("iops"), *dataVolume.IOPS, "must be greater than 0"))
+		}
+		if dataVolume.ThroughputMBps != nil && *dataVolume.ThroughputMBps <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("throughputMBps"), *dataVolume.ThroughputMBps, "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateResourceQuantityValue(key corev1.ResourceName, value resource.Quantity, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -5,12 +5,16 @@
 package validation_test
 
 import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/gardener/gardener/pkg/apis/core"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
@@ -78,6 +82,1146 @@ var _ = Describe("ValidateWorkerConfig", func() {
 				})),
 			))
 		})
+
+		Context("disk encryption", func() {
+			It("should allow a disk encryption set id", func() {
+				worker.DiskEncryption = &apisazure.DiskEncryption{
+					DiskEncryptionSetID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/des"),
+					EncryptionAtHost:    to.Ptr(true),
+				}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid resource id", func() {
+				worker.DiskEncryption = &apisazure.DiskEncryption{DiskEncryptionSetID: to.Ptr("des")}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.diskEncryption.diskEncryptionSetID"),
+					})),
+				))
+			})
+
+			It("should forbid the id of a resource which is not a disk encryption set", func() {
+				worker.DiskEncryption = &apisazure.DiskEncryption{
+					DiskEncryptionSetID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/vault"),
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.diskEncryption.diskEncryptionSetID"),
+					})),
+				))
+			})
+		})
+
+		Context("spot", func() {
+			It("should allow a valid spot configuration", func() {
+				worker.Spot = &apisazure.SpotConfig{
+					Priority:       to.Ptr(apisazure.VMPrioritySpot),
+					EvictionPolicy: to.Ptr(apisazure.SpotEvictionPolicyDeallocate),
+					MaxPrice:       to.Ptr("0.01234"),
+				}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+
+				worker.Spot.MaxPrice = to.Ptr("-1")
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unknown priorities and eviction policies", func() {
+				worker.Spot = &apisazure.SpotConfig{
+					Priority:       to.Ptr(apisazure.VMPriority("Regular")),
+					EvictionPolicy: to.Ptr(apisazure.SpotEvictionPolicy("Stop")),
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.spot.priority"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.spot.evictionPolicy"),
+					})),
+				))
+			})
+
+			DescribeTable("should forbid invalid max prices",
+				func(maxPrice string) {
+					worker.Spot = &apisazure.SpotConfig{MaxPrice: &maxPrice}
+
+					Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("config.spot.maxPrice"),
+						})),
+					))
+				},
+				Entry("not a number", "cheap"),
+				Entry("zero", "0"),
+				Entry("negative", "-0.5"),
+				Entry("too many decimal places", "0.000001"),
+			)
+		})
+
+		Context("osDisk", func() {
+			It("should forbid unknown ephemeral placements", func() {
+				worker.OSDisk = &apisazure.OSDiskConfig{EphemeralPlacement: to.Ptr(apisazure.EphemeralOSDiskPlacement("NvmeDisk"))}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.osDisk.ephemeralPlacement"),
+					})),
+				))
+			})
+
+			It("should forbid deallocating evicted spot machines with an ephemeral OS disk", func() {
+				worker.OSDisk = &apisazure.OSDiskConfig{EphemeralPlacement: to.Ptr(apisazure.EphemeralOSDiskPlacementCacheDisk)}
+				worker.Spot = &apisazure.SpotConfig{EvictionPolicy: to.Ptr(apisazure.SpotEvictionPolicyDeallocate)}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.spot.evictionPolicy"),
+					})),
+				))
+			})
+		})
+		Context("dataVolumes", func() {
+			It("should allow valid data volume settings", func() {
+				worker.DataVolumes = []apisazure.DataVolume{
+					{Name: "data1", Caching: to.Ptr(apisazure.CachingTypeReadOnly)},
+					{Name: "data2", IOPS: to.Ptr[int64](5000), ThroughputMBps: to.Ptr[int64](200)},
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid data volume settings", func() {
+				worker.DataVolumes = []apisazure.DataVolume{
+					{Name: "data1", Caching: to.Ptr(apisazure.CachingType("WriteOnly"))},
+					{Name: "data1", IOPS: to.Ptr[int64](0), ThroughputMBps: to.Ptr[int64](-1)},
+					{},
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.dataVolumes[0].caching"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("config.dataVolumes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[1].iops"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dataVolumes[1].throughputMBps"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.dataVolumes[2].name"),
+					})),
+				))
+			})
+		})
+		Context("security", func() {
+			It("should allow trusted launch and confidential VMs", func() {
+				worker.Security = &apisazure.SecurityConfig{Type: to.Ptr(apisazure.SecurityTypeTrustedLaunch), SecureBoot: to.Ptr(false)}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+
+				worker.Security = &apisazure.SecurityConfig{
+					Type:                 to.Ptr(apisazure.SecurityTypeConfidentialVM),
+					OSDiskEncryptionType: to.Ptr(apisazure.SecurityEncryptionTypeDiskWithVMGuestState),
+				}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid unknown security and encryption types", func() {
+				worker.Security = &apisazure.SecurityConfig{Type: to.Ptr(apisazure.SecurityType("Standard"))}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.security.type"),
+					})),
+				))
+
+				worker.Security = &apisazure.SecurityConfig{
+					Type:                 to.Ptr(apisazure.SecurityTypeConfidentialVM),
+					OSDiskEncryptionType: to.Ptr(apisazure.SecurityEncryptionType("NonPersistedTPM")),
+				}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("config.security.osDiskEncryptionType"),
+					})),
+				))
+			})
+
+			It("should forbid an OS disk encryption type for trusted launch", func() {
+				worker.Security = &apisazure.SecurityConfig{
+					Type:                 to.Ptr(apisazure.SecurityTypeTrustedLaunch),
+					OSDiskEncryptionType: to.Ptr(apisazure.SecurityEncryptionTypeVMGuestStateOnly),
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.security.osDiskEncryptionType"),
+					})),
+				))
+			})
+
+			It("should forbid confidential VMs without vTPM or with an ephemeral OS disk", func() {
+				worker.Security = &apisazure.SecurityConfig{Type: to.Ptr(apisazure.SecurityTypeConfidentialVM), VTPM: to.Ptr(false)}
+				worker.OSDisk = &apisazure.OSDiskConfig{EphemeralPlacement: to.Ptr(apisazure.EphemeralOSDiskPlacementCacheDisk)}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.security.vTPM"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.osDisk.ephemeralPlacement"),
+					})),
+				))
+			})
+		})
+
+		Context("dedicatedHost", func() {
+			const hostGroupID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"
+
+			It("should allow a host group with or without a host", func() {
+				worker.DedicatedHost = &apisazure.DedicatedHostConfig{HostGroupID: hostGroupID}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+
+				worker.DedicatedHost.HostID = to.Ptr(hostGroupID + "/hosts/host")
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid ids which do not reference a host group or a host of it", func() {
+				worker.DedicatedHost = &apisazure.DedicatedHostConfig{
+					HostGroupID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/des",
+					HostID:      to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/other/hosts/host"),
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dedicatedHost.hostGroupID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("config.dedicatedHost.hostID"),
+						"Detail": Equal("must be the id of a host of the dedicated host group"),
+					})),
+				))
+			})
+
+			It("should forbid an invalid host id", func() {
+				worker.DedicatedHost = &apisazure.DedicatedHostConfig{HostGroupID: hostGroupID, HostID: to.Ptr("host")}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.dedicatedHost.hostID"),
+					})),
+				))
+			})
+
+			It("should forbid spot machines on dedicated hosts", func() {
+				worker.DedicatedHost = &apisazure.DedicatedHostConfig{HostGroupID: hostGroupID}
+				worker.Spot = &apisazure.SpotConfig{}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.spot"),
+					})),
+				))
+			})
+		})
+
+		Context("capacityReservation", func() {
+			const capacityReservationGroupID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/capacityReservationGroups/crg"
+
+			It("should allow a capacity reservation group", func() {
+				worker.CapacityReservation = &apisazure.CapacityReservationConfig{CapacityReservationGroupID: capacityReservationGroupID}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid ids which do not reference a capacity reservation group", func() {
+				worker.CapacityReservation = &apisazure.CapacityReservationConfig{CapacityReservationGroupID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.capacityReservation.capacityReservationGroupID"),
+					})),
+				))
+
+				worker.CapacityReservation.CapacityReservationGroupID = "crg"
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.capacityReservation.capacityReservationGroupID"),
+					})),
+				))
+			})
+
+			It("should forbid capacity reservations for spot machines and machines on dedicated hosts", func() {
+				worker.CapacityReservation = &apisazure.CapacityReservationConfig{CapacityReservationGroupID: capacityReservationGroupID}
+				worker.DedicatedHost = &apisazure.DedicatedHostConfig{HostGroupID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"}
+				worker.Spot = &apisazure.SpotConfig{}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("config.spot"),
+						"Detail": Equal("spot machines cannot be placed on dedicated hosts"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("config.spot"),
+						"Detail": Equal("spot machines cannot use capacity reservations"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.capacityReservation"),
+					})),
+				))
+			})
+		})
+
+		Context("applicationSecurityGroups", func() {
+			It("should allow references by name or id", func() {
+				worker.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupReference{
+					{Name: to.Ptr("web")},
+					{ID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/applicationSecurityGroups/asg")},
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid references with neither or both of name and id", func() {
+				worker.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupReference{
+					{},
+					{Name: to.Ptr("web"), ID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/applicationSecurityGroups/asg")},
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.applicationSecurityGroups[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.applicationSecurityGroups[1]"),
+					})),
+				))
+			})
+
+			It("should forbid ids which do not reference an application security group", func() {
+				worker.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroupReference{
+					{ID: to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/networkSecurityGroups/nsg")},
+				}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.applicationSecurityGroups[0].id"),
+					})),
+				))
+			})
+		})
+
+		Context("network interfaces", func() {
+			It("should allow secondary IP configurations and additional network interfaces", func() {
+				worker.SecondaryIPConfigurations = to.Ptr[int32](2)
+				worker.AdditionalNetworkInterfaces = []apisazure.NetworkInterface{{}, {SubnetName: to.Ptr("dmz"), SecondaryIPConfigurations: to.Ptr[int32](255)}}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid invalid numbers of secondary IP configurations", func() {
+				worker.SecondaryIPConfigurations = to.Ptr[int32](-1)
+				worker.AdditionalNetworkInterfaces = []apisazure.NetworkInterface{{SecondaryIPConfigurations: to.Ptr[int32](256)}}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.secondaryIPConfigurations"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.additionalNetworkInterfaces[0].secondaryIPConfigurations"),
+					})),
+				))
+			})
+		})
+
+		Context("boot diagnostics", func() {
+			It("should allow boot diagnostics with a managed or a referenced storage account", func() {
+				worker.BootDiagnostics = &apisazure.BootDiagnostics{Enabled: true}
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+
+				worker.BootDiagnostics.StorageURI = to.Ptr("https://mystorageaccount.blob.core.windows.net/")
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid a storage URI which is not a https endpoint", func() {
+				worker.BootDiagnostics = &apisazure.BootDiagnostics{Enabled: true, StorageURI: to.Ptr("http://mystorageaccount.blob.core.windows.net/")}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.bootDiagnostics.storageURI"),
+					})),
+				))
+			})
+
+			It("should forbid a storage URI if boot diagnostics are disabled", func() {
+				worker.BootDiagnostics = &apisazure.BootDiagnostics{StorageURI: to.Ptr("https://mystorageaccount.blob.core.windows.net/")}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.bootDiagnostics.storageURI"),
+					})),
+				))
+			})
+		})
+
+		Context("extensions", func() {
+			It("should allow valid extensions", func() {
+				worker.Extensions = []apisazure.VMExtension{{
+					Name:                       "monitor",
+					Publisher:                  "Microsoft.Azure.Monitor",
+					Type:                       "AzureMonitorLinuxAgent",
+					TypeHandlerVersion:         "1.29",
+					Settings:                   &runtime.RawExtension{Raw: []byte(`{"workspaceId": "abc"}`)},
+					ProtectedSettingsSecretRef: to.Ptr("monitor-key"),
+				}}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid incomplete extensions", func() {
+				worker.Extensions = []apisazure.VMExtension{{
+					Settings:                   &runtime.RawExtension{Raw: []byte(`["abc"]`)},
+					ProtectedSettingsSecretRef: to.Ptr(""),
+				}}
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.extensions[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.extensions[0].publisher"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.extensions[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.extensions[0].typeHandlerVersion"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.extensions[0].settings"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("config.extensions[0].protectedSettingsSecretRef"),
+					})),
+				))
+			})
+
+			It("should forbid invalid and duplicate names", func() {
+				extension := apisazure.VMExtension{Publisher: "p", Type: "t", TypeHandlerVersion: "1.0"}
+				worker.Extensions = []apisazure.VMExtension{extension, extension, extension}
+				worker.Extensions[0].Name = "monitor"
+				worker.Extensions[1].Name = "monitor"
+				worker.Extensions[2].Name = "mon/itor"
+
+				Expect(ValidateWorkerConfig(worker, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("config.extensions[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("config.extensions[2].name"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstResources", func() {
+		var (
+			workerConfig *apisazure.WorkerConfig
+			resources    []core.NamedResourceReference
+			fldPath      = field.NewPath("providerConfig")
+		)
+
+		BeforeEach(func() {
+			workerConfig = &apisazure.WorkerConfig{
+				Extensions: []apisazure.VMExtension{
+					{Name: "monitor", ProtectedSettingsSecretRef: to.Ptr("monitor-key")},
+					{Name: "other"},
+				},
+			}
+			resources = []core.NamedResourceReference{{
+				Name:        "monitor-key",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "monitor", APIVersion: "v1"},
+			}}
+		})
+
+		It("should allow protected settings from referenced secrets", func() {
+			Expect(ValidateWorkerConfigAgainstResources(workerConfig, resources, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid references to unknown resources", func() {
+			resources = nil
+
+			Expect(ValidateWorkerConfigAgainstResources(workerConfig, resources, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("providerConfig.extensions[0].protectedSettingsSecretRef"),
+				})),
+			))
+		})
+
+		It("should forbid references to resources which are no secrets", func() {
+			resources[0].ResourceRef.Kind = "ConfigMap"
+
+			Expect(ValidateWorkerConfigAgainstResources(workerConfig, resources, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("providerConfig.extensions[0].protectedSettingsSecretRef"),
+				})),
+			))
+		})
 	})
 
+	Describe("#ValidateWorkerConfigAgainstReconciler", func() {
+		var (
+			worker  *apisazure.WorkerConfig
+			fldPath = field.NewPath("config")
+		)
+
+		BeforeEach(func() {
+			worker = &apisazure.WorkerConfig{
+				SubnetName:                  to.Ptr("dmz"),
+				AdditionalNetworkInterfaces: []apisazure.NetworkInterface{{SubnetName: to.Ptr("storage")}},
+			}
+		})
+
+		It("should allow additional subnets if the infrastructure is reconciled with flow", func() {
+			Expect(ValidateWorkerConfigAgainstReconciler(worker, true, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid additional subnets if the infrastructure is not reconciled with flow", func() {
+			Expect(ValidateWorkerConfigAgainstReconciler(worker, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.subnetName"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.additionalNetworkInterfaces[0].subnetName"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstInfrastructure", func() {
+		var (
+			worker  *apisazure.WorkerConfig
+			fldPath = field.NewPath("config")
+		)
+
+		BeforeEach(func() {
+			worker = &apisazure.WorkerConfig{Spot: &apisazure.SpotConfig{}}
+		})
+
+		It("should allow spot machines in zonal clusters", func() {
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{Zoned: true}, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow spot machines in non-zonal clusters using VMO", func() {
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, true, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow spot machines in non-zonal clusters if the worker pool is migrated to VMO", func() {
+			mode := apisazure.OrchestrationModeVMSSFlex
+			worker.OrchestrationMode = &mode
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow spot machines in non-zonal clusters using the VMSSFlex orchestration mode", func() {
+			mode := apisazure.OrchestrationModeVMSSFlex
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{OrchestrationMode: &mode}, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should only allow the VMSSFlex orchestration mode for worker pools of non-zonal clusters", func() {
+			mode := apisazure.OrchestrationModeVMSSFlex
+			worker = &apisazure.WorkerConfig{OrchestrationMode: &mode}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{Zoned: true}, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.orchestrationMode"),
+				})),
+			))
+
+			mode = apisazure.OrchestrationModeAvailabilitySet
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.orchestrationMode"),
+				})),
+			))
+		})
+
+		It("should forbid ephemeral OS disks if a disk encryption set is configured in the infrastructure config", func() {
+			worker = &apisazure.WorkerConfig{OSDisk: &apisazure.OSDiskConfig{EphemeralPlacement: to.Ptr(apisazure.EphemeralOSDiskPlacementResourceDisk)}}
+			infra := &apisazure.InfrastructureConfig{
+				Zoned:          true,
+				DiskEncryption: &apisazure.DiskEncryption{DiskEncryptionSetID: to.Ptr("des")},
+			}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.osDisk.ephemeralPlacement"),
+				})),
+			))
+		})
+
+		It("should forbid encryption at host for confidential VMs", func() {
+			worker = &apisazure.WorkerConfig{Security: &apisazure.SecurityConfig{Type: to.Ptr(apisazure.SecurityTypeConfidentialVM)}}
+			infra := &apisazure.InfrastructureConfig{
+				Zoned:          true,
+				DiskEncryption: &apisazure.DiskEncryption{EncryptionAtHost: to.Ptr(true)},
+			}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.security.type"),
+				})),
+			))
+
+			worker.DiskEncryption = &apisazure.DiskEncryption{EncryptionAtHost: to.Ptr(false)}
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should only allow disk encryption sets for confidential VMs which encrypt the whole OS disk", func() {
+			worker = &apisazure.WorkerConfig{
+				Security: &apisazure.SecurityConfig{
+					Type:                 to.Ptr(apisazure.SecurityTypeConfidentialVM),
+					OSDiskEncryptionType: to.Ptr(apisazure.SecurityEncryptionTypeVMGuestStateOnly),
+				},
+				DiskEncryption: &apisazure.DiskEncryption{DiskEncryptionSetID: to.Ptr("des")},
+			}
+			infra := &apisazure.InfrastructureConfig{Zoned: true}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.security.osDiskEncryptionType"),
+				})),
+			))
+
+			worker.Security.OSDiskEncryptionType = to.Ptr(apisazure.SecurityEncryptionTypeDiskWithVMGuestState)
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid capacity reservations in clusters using an availability set", func() {
+			worker = &apisazure.WorkerConfig{CapacityReservation: &apisazure.CapacityReservationConfig{CapacityReservationGroupID: "crg"}}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{Zoned: true}, false, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, true, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.capacityReservation"),
+				})),
+			))
+		})
+
+		It("should forbid proximity placement groups in non-zonal clusters", func() {
+			worker = &apisazure.WorkerConfig{ProximityPlacementGroup: &apisazure.ProximityPlacementGroupConfig{}}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{Zoned: true}, false, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, true, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.proximityPlacementGroup"),
+				})),
+			))
+		})
+
+		It("should forbid dedicated hosts in non-zonal clusters", func() {
+			worker = &apisazure.WorkerConfig{DedicatedHost: &apisazure.DedicatedHostConfig{HostGroupID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"}}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{Zoned: true}, false, fldPath)).To(BeEmpty())
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dedicatedHost"),
+				})),
+			))
+		})
+
+		It("should forbid references to application security groups which are not part of the infrastructure", func() {
+			worker = &apisazure.WorkerConfig{ApplicationSecurityGroups: []apisazure.ApplicationSecurityGroupReference{{Name: to.Ptr("web")}, {Name: to.Ptr("db")}}}
+			infra := &apisazure.InfrastructureConfig{
+				Zoned: true,
+				Networks: apisazure.NetworkConfig{
+					ApplicationSecurityGroups: []apisazure.ApplicationSecurityGroupConfig{{Name: "web"}},
+				},
+			}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("config.applicationSecurityGroups[1].name"),
+				})),
+			))
+		})
+
+		It("should forbid selecting a subnet which is not part of the infrastructure", func() {
+			worker = &apisazure.WorkerConfig{SubnetName: to.Ptr("dmz")}
+			infra := &apisazure.InfrastructureConfig{Zoned: true}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("config.subnetName"),
+				})),
+			))
+
+			infra.Networks.AdditionalSubnets = []apisazure.AdditionalSubnet{{Name: "dmz", CIDR: "10.251.0.0/24"}}
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid network interfaces in subnets which are not part of the infrastructure", func() {
+			worker = &apisazure.WorkerConfig{AdditionalNetworkInterfaces: []apisazure.NetworkInterface{{}, {SubnetName: to.Ptr("storage")}}}
+			infra := &apisazure.InfrastructureConfig{Zoned: true}
+
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, infra, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("config.additionalNetworkInterfaces[1].subnetName"),
+				})),
+			))
+		})
+
+		It("should forbid spot machines in clusters using an availability set", func() {
+			Expect(ValidateWorkerConfigAgainstInfrastructure(worker, &apisazure.InfrastructureConfig{}, false, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.spot"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAvailabilitySetWorkerConfig", func() {
+		fldPath := field.NewPath("config")
+
+		It("should allow a worker config without zonal features", func() {
+			Expect(ValidateAvailabilitySetWorkerConfig(&apisazure.WorkerConfig{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid features which are not supported by the availability set", func() {
+			worker := &apisazure.WorkerConfig{
+				Spot:                    &apisazure.SpotConfig{},
+				ProximityPlacementGroup: &apisazure.ProximityPlacementGroupConfig{},
+				CapacityReservation:     &apisazure.CapacityReservationConfig{CapacityReservationGroupID: "crg"},
+			}
+
+			Expect(ValidateAvailabilitySetWorkerConfig(worker, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.spot"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.proximityPlacementGroup"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.capacityReservation"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWindowsWorkers", func() {
+		var (
+			cloudProfileConfig *apisazure.CloudProfileConfig
+			workers            []core.Worker
+			fldPath            = field.NewPath("workers")
+		)
+
+		BeforeEach(func() {
+			windows := apisazure.OperatingSystemWindows
+			cloudProfileConfig = &apisazure.CloudProfileConfig{
+				MachineImages: []apisazure.MachineImages{
+					{Name: "gardenlinux", Versions: []apisazure.MachineImageVersion{{Version: "1.0.0", Architecture: to.Ptr("amd64")}}},
+					{Name: "windows-server", Versions: []apisazure.MachineImageVersion{{Version: "2022.0.0", Architecture: to.Ptr("amd64"), OperatingSystem: &windows}}},
+				},
+			}
+			workers = []core.Worker{
+				{Name: "linux", Machine: core.Machine{Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"}}},
+				{Name: "windows", Machine: core.Machine{Image: &core.ShootMachineImage{Name: "windows-server", Version: "2022.0.0"}}, SystemComponents: &core.WorkerSystemComponents{Allow: false}},
+			}
+		})
+
+		It("should allow windows worker pools which do not host system components", func() {
+			Expect(ValidateWindowsWorkers(workers, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid windows worker pools which host system components", func() {
+			workers[1].SystemComponents = nil
+
+			Expect(ValidateWindowsWorkers(workers, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("workers[1].systemComponents.allow"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstCloudProfile", func() {
+		var (
+			workerConfig       *apisazure.WorkerConfig
+			worker             core.Worker
+			cloudProfileConfig *apisazure.CloudProfileConfig
+			fldPath            = field.NewPath("config")
+		)
+
+		BeforeEach(func() {
+			workerConfig = &apisazure.WorkerConfig{OSDisk: &apisazure.OSDiskConfig{EphemeralPlacement: to.Ptr(apisazure.EphemeralOSDiskPlacementCacheDisk)}}
+			worker = core.Worker{
+				Machine: core.Machine{Type: "Standard_D4s_v3"},
+				Volume:  &core.Volume{VolumeSize: "50Gi"},
+			}
+			cloudProfileConfig = &apisazure.CloudProfileConfig{
+				MachineTypes: []apisazure.MachineType{{
+					Name:               "Standard_D4s_v3",
+					CacheDiskSizeGB:    to.Ptr[int32](100),
+					ResourceDiskSizeGB: to.Ptr[int32](32),
+				}},
+			}
+		})
+
+		It("should allow an ephemeral OS disk which fits on the cache disk", func() {
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid an ephemeral OS disk which is larger than the resource disk", func() {
+			workerConfig.OSDisk.EphemeralPlacement = to.Ptr(apisazure.EphemeralOSDiskPlacementResourceDisk)
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.osDisk.ephemeralPlacement"),
+				})),
+			))
+		})
+
+		It("should forbid an ephemeral OS disk if the machine type does not support it", func() {
+			cloudProfileConfig.MachineTypes[0].EphemeralOSDisk = to.Ptr(false)
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.osDisk.ephemeralPlacement"),
+				})),
+			))
+		})
+
+		Context("security", func() {
+			BeforeEach(func() {
+				workerConfig = &apisazure.WorkerConfig{Security: &apisazure.SecurityConfig{Type: to.Ptr(apisazure.SecurityTypeConfidentialVM)}}
+				worker.Machine.Image = &core.ShootMachineImage{Name: "gardenlinux", Version: "1443.3.0"}
+				cloudProfileConfig.MachineImages = []apisazure.MachineImages{{
+					Name: "gardenlinux",
+					Versions: []apisazure.MachineImageVersion{
+						{Version: "1443.3.0", Architecture: to.Ptr("amd64"), SecurityTypes: []apisazure.SecurityType{apisazure.SecurityTypeTrustedLaunch, apisazure.SecurityTypeConfidentialVM}},
+						{Version: "1443.3.0", Architecture: to.Ptr("arm64"), SecurityTypes: []apisazure.SecurityType{apisazure.SecurityTypeTrustedLaunch}},
+						{Version: "1312.3.0", Architecture: to.Ptr("amd64")},
+					},
+				}}
+			})
+
+			It("should allow security types which are supported by the image", func() {
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid security types which are not supported by the image", func() {
+				worker.Machine.Architecture = to.Ptr("arm64")
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.security.type"),
+					})),
+				))
+			})
+
+			It("should not restrict the security type if the image or the machine type does not declare any", func() {
+				worker.Machine.Image.Version = "1312.3.0"
+				cloudProfileConfig.MachineTypes[0].SecurityTypes = nil
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+
+				cloudProfileConfig.MachineTypes[0].SecurityTypes = []apisazure.SecurityType{}
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should validate a missing security type as trusted launch against the image", func() {
+				workerConfig.Security.Type = nil
+				worker.Machine.Architecture = to.Ptr("arm64")
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+
+				cloudProfileConfig.MachineImages[0].Versions[1].SecurityTypes = []apisazure.SecurityType{apisazure.SecurityTypeConfidentialVM}
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("config.security.type"),
+						"Detail": ContainSubstring("machine image \"gardenlinux\" in version \"1443.3.0\" does not support the security type TrustedLaunch"),
+					})),
+				))
+			})
+
+			It("should forbid security types which are not supported by the machine type", func() {
+				cloudProfileConfig.MachineTypes[0].SecurityTypes = []apisazure.SecurityType{apisazure.SecurityTypeTrustedLaunch}
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("config.security.type"),
+						"Detail": ContainSubstring("machine type"),
+					})),
+				))
+
+				workerConfig.Security.Type = nil
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+		})
+
+		Context("network interfaces", func() {
+			BeforeEach(func() {
+				workerConfig = &apisazure.WorkerConfig{AdditionalNetworkInterfaces: []apisazure.NetworkInterface{{AcceleratedNetworking: to.Ptr(true)}}}
+				cloudProfileConfig.MachineTypes[0].MaxNetworkInterfaces = to.Ptr[int32](2)
+				cloudProfileConfig.MachineTypes[0].AcceleratedNetworking = to.Ptr(true)
+			})
+
+			It("should allow network interfaces which are supported by the machine type", func() {
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(BeEmpty())
+			})
+
+			It("should forbid more network interfaces or accelerated networking if the machine type does not support them", func() {
+				workerConfig.AdditionalNetworkInterfaces = append(workerConfig.AdditionalNetworkInterfaces, apisazure.NetworkInterface{})
+				cloudProfileConfig.MachineTypes[0].AcceleratedNetworking = nil
+
+				Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("config.additionalNetworkInterfaces"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("config.additionalNetworkInterfaces[0].acceleratedNetworking"),
+					})),
+				))
+			})
+		})
+
+		It("should forbid an ephemeral OS disk if the machine type does not declare the size of the local disk", func() {
+			worker.Machine.Type = "Standard_D2s_v3"
+
+			Expect(ValidateWorkerConfigAgainstCloudProfile(workerConfig, worker, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.osDisk.ephemeralPlacement"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerConfigAgainstWorker", func() {
+		var (
+			workerConfig *apisazure.WorkerConfig
+			worker       core.Worker
+			fldPath      = field.NewPath("config")
+		)
+
+		BeforeEach(func() {
+			workerConfig = &apisazure.WorkerConfig{}
+			worker = core.Worker{
+				DataVolumes: []core.DataVolume{
+					{Name: "premium", Type: to.Ptr("Premium_LRS")},
+					{Name: "ultra", Type: to.Ptr("UltraSSD_LRS")},
+				},
+			}
+		})
+
+		It("should allow settings which are supported by the volume types", func() {
+			workerConfig.DataVolumes = []apisazure.DataVolume{
+				{Name: "premium", Caching: to.Ptr(apisazure.CachingTypeReadWrite)},
+				{Name: "ultra", Caching: to.Ptr(apisazure.CachingTypeNone), IOPS: to.Ptr[int64](5000), ThroughputMBps: to.Ptr[int64](200)},
+			}
+
+			Expect(ValidateWorkerConfigAgainstWorker(workerConfig, worker, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid settings for unknown data volumes", func() {
+			workerConfig.DataVolumes = []apisazure.DataVolume{{Name: "foo"}}
+
+			Expect(ValidateWorkerConfigAgainstWorker(workerConfig, worker, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("config.dataVolumes[0].name"),
+				})),
+			))
+		})
+
+		It("should only allow dedicated hosts for worker pools with a single zone", func() {
+			workerConfig.DedicatedHost = &apisazure.DedicatedHostConfig{HostGroupID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"}
+			worker.Zones = []string{"1"}
+			Expect(ValidateWorkerConfigAgainstWorker(workerConfig, worker, fldPath)).To(BeEmpty())
+
+			worker.Zones = []string{"1", "2"}
+			Expect(ValidateWorkerConfigAgainstWorker(workerConfig, worker, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dedicatedHost"),
+				})),
+			))
+		})
+
+		It("should forbid settings which are not supported by the volume types", func() {
+			workerConfig.DataVolumes = []apisazure.DataVolume{
+				{Name: "premium", IOPS: to.Ptr[int64](5000), ThroughputMBps: to.Ptr[int64](200)},
+				{Name: "ultra", Caching: to.Ptr(apisazure.CachingTypeReadOnly)},
+			}
+
+			Expect(ValidateWorkerConfigAgainstWorker(workerConfig, worker, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dataVolumes[0].iops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dataVolumes[0].throughputMBps"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.dataVolumes[1].caching"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateWorkerAgainstCloudProfile", func() {
+		var (
+			worker             core.Worker
+			cloudProfileConfig *apisazure.CloudProfileConfig
+			fldPath            = field.NewPath("worker")
+		)
+
+		BeforeEach(func() {
+			worker = core.Worker{
+				Machine:     core.Machine{Type: "Standard_D4s_v3"},
+				Volume:      &core.Volume{Type: to.Ptr("Premium_LRS"), VolumeSize: "50Gi"},
+				DataVolumes: []core.DataVolume{{Name: "data", Type: to.Ptr("UltraSSD_LRS"), VolumeSize: "100Gi"}},
+			}
+			cloudProfileConfig = &apisazure.CloudProfileConfig{
+				MachineTypes: []apisazure.MachineType{
+					{Name: "Standard_D4s_v3", UltraSSD: to.Ptr(true)},
+					{Name: "Standard_D4_v3", PremiumIO: to.Ptr(false)},
+				},
+			}
+		})
+
+		It("should allow volume types which are supported by the machine type", func() {
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow all volume types if the machine type is not declared", func() {
+			worker.Machine.Type = "Standard_E4s_v3"
+
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid volume types which are not supported by the machine type", func() {
+			worker.Machine.Type = "Standard_D4_v3"
+
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.volume.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.dataVolumes[0].type"),
+				})),
+			))
+		})
+
+		It("should forbid more data volumes than the machine type supports", func() {
+			cloudProfileConfig.MachineTypes[0].MaxDataDisks = to.Ptr[int32](0)
+
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("worker.dataVolumes"),
+				})),
+			))
+		})
+
+		It("should forbid architectures which are not supported by the machine type", func() {
+			cloudProfileConfig.MachineTypes[0].Architectures = []string{"arm64"}
+
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeNotSupported),
+					"Field":    Equal("worker.machine.architecture"),
+					"BadValue": Equal("amd64"),
+				})),
+			))
+		})
+
+		It("should forbid zones in which the machine type is not available", func() {
+			worker.Zones = []string{"1", "3"}
+			cloudProfileConfig.MachineTypes[0].Zones = []apisazure.MachineTypeZones{
+				{Region: "westeurope", Names: []string{"1", "2"}},
+				{Region: "northeurope", Names: []string{"3"}},
+			}
+
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "westeurope", cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("worker.zones[1]"),
+				})),
+			))
+			Expect(ValidateWorkerAgainstCloudProfile(worker, "eastus", cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ ApplicationSecurityGroup = &ApplicationSecurityGroupClient{}
+
+// ApplicationSecurityGroupClient is an implementation of ApplicationSecurityGroup for an application security group k8sClient.
+type ApplicationSecurityGroupClient struct {
+	client *armnetwork.ApplicationSecurityGroupsClient
+}
+
+// NewApplicationSecurityGroupClient creates a new ApplicationSecurityGroupClient.
+func NewApplicationSecurityGroupClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ApplicationSecurityGroupClient, error) {
+	client, err := armnetwork.NewApplicationSecurityGroupsClient(auth.SubscriptionID, tc, opts)
+	return &ApplicationSecurityGroupClient{client}, err
+}
+
+// CreateOrUpdate creates or updates an application security group.
+func (c *ApplicationSecurityGroupClient) CreateOrUpdate(ctx context.Context, resourceGroupName, name string, parameters armnetwork.ApplicationSecurityGroup) (*armnetwork.ApplicationSecurityGroup, error) {
+	poller, err := c.client.BeginCreateOrUpdate(ctx, resourceGroupName, name, parameters, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := poller.PollUntilDone(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &res.ApplicationSecurityGroup, nil
+}
+
+// Get returns the application security group for the given resource group and name.
+func (c *ApplicationSecurityGroupClient) Get(ctx context.Context, resourceGroupName, name string) (*armnetwork.ApplicationSecurityGroup, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.ApplicationSecurityGroup, nil
+}
+
+// Delete deletes the application security group with the given name.
+func (c *ApplicationSecurityGroupClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
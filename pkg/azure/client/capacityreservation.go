@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var (
+	_ CapacityReservationGroup = &CapacityReservationGroupClient{}
+	_ CapacityReservation      = &CapacityReservationClient{}
+)
+
+// CapacityReservationGroupClient is an implementation of CapacityReservationGroup for a capacity reservation group k8sClient.
+type CapacityReservationGroupClient struct {
+	client *armcompute.CapacityReservationGroupsClient
+}
+
+// NewCapacityReservationGroupClient creates a new CapacityReservationGroupClient.
+func NewCapacityReservationGroupClient(auth internal.ClientAuth) (*CapacityReservationGroupClient, error) {
+	cred, err := auth.GetAzClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewCapacityReservationGroupsClient(auth.SubscriptionID, cred, nil)
+	return &CapacityReservationGroupClient{client}, err
+}
+
+// Get returns the capacity reservation group for the given resource group and capacity reservation group name.
+func (c *CapacityReservationGroupClient) Get(ctx context.Context, resourceGroupName, capacityReservationGroupName string) (*armcompute.CapacityReservationGroup, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, capacityReservationGroupName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.CapacityReservationGroup, nil
+}
+
+// CapacityReservationClient is an implementation of CapacityReservation for a capacity reservation k8sClient.
+type CapacityReservationClient struct {
+	client *armcompute.CapacityReservationsClient
+}
+
+// NewCapacityReservationClient creates a new CapacityReservationClient.
+func NewCapacityReservationClient(auth internal.ClientAuth) (*CapacityReservationClient, error) {
+	cred, err := auth.GetAzClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewCapacityReservationsClient(auth.SubscriptionID, cred, nil)
+	return &CapacityReservationClient{client}, err
+}
+
+// Get returns the capacity reservation with the given name of the given capacity reservation group.
+func (c *CapacityReservationClient) Get(ctx context.Context, resourceGroupName, capacityReservationGroupName, capacityReservationName string) (*armcompute.CapacityReservation, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, capacityReservationGroupName, capacityReservationName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.CapacityReservation, nil
+}
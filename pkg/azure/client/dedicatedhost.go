@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var (
+	_ DedicatedHostGroup = &DedicatedHostGroupClient{}
+	_ DedicatedHost      = &DedicatedHostClient{}
+)
+
+// DedicatedHostGroupClient is an implementation of DedicatedHostGroup for a dedicated host group k8sClient.
+type DedicatedHostGroupClient struct {
+	client *armcompute.DedicatedHostGroupsClient
+}
+
+// NewDedicatedHostGroupClient creates a new DedicatedHostGroupClient.
+func NewDedicatedHostGroupClient(auth internal.ClientAuth) (*DedicatedHostGroupClient, error) {
+	cred, err := auth.GetAzClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDedicatedHostGroupsClient(auth.SubscriptionID, cred, nil)
+	return &DedicatedHostGroupClient{client}, err
+}
+
+// Get returns the dedicated host group for the given resource group and host group name.
+func (c *DedicatedHostGroupClient) Get(ctx context.Context, resourceGroupName, hostGroupName string) (*armcompute.DedicatedHostGroup, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, hostGroupName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.DedicatedHostGroup, nil
+}
+
+// DedicatedHostClient is an implementation of DedicatedHost for a dedicated host k8sClient.
+type DedicatedHostClient struct {
+	client *armcompute.DedicatedHostsClient
+}
+
+// NewDedicatedHostClient creates a new DedicatedHostClient.
+func NewDedicatedHostClient(auth internal.ClientAuth) (*DedicatedHostClient, error) {
+	cred, err := auth.GetAzClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewDedicatedHostsClient(auth.SubscriptionID, cred, nil)
+	return &DedicatedHostClient{client}, err
+}
+
+// Get returns the dedicated host with the given name of the given host group. The instance view contains the
+// remaining capacity of the host.
+func (c *DedicatedHostClient) Get(ctx context.Context, resourceGroupName, hostGroupName, hostName string, expand *armcompute.InstanceViewTypes) (*armcompute.DedicatedHost, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, hostGroupName, hostName, &armcompute.DedicatedHostsClientGetOptions{Expand: expand})
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.DedicatedHost, nil
+}
@@ -118,6 +118,11 @@ func (f azureFactory) NetworkSecurityGroup() (NetworkSecurityGroup, error) {
 	return NewSecurityGroupClient(*f.auth, f.tokenCredential, DefaultAzureClientOpts())
 }
 
+// ApplicationSecurityGroup returns an Azure application security group client.
+func (f azureFactory) ApplicationSecurityGroup() (ApplicationSecurityGroup, error) {
+	return NewApplicationSecurityGroupClient(*f.auth, f.tokenCredential, DefaultAzureClientOpts())
+}
+
 // PublicIP reads the secret from the passed reference and return an Azure network PublicIPClient.
 func (f azureFactory) PublicIP() (PublicIP, error) {
 	return NewPublicIPClient(*f.auth, f.tokenCredential, DefaultAzureClientOpts())
@@ -154,6 +159,11 @@ func (f azureFactory) NatGateway() (NatGateway, error) {
 	return NewNatGatewaysClient(*f.auth, f.tokenCredential, DefaultAzureClientOpts())
 }
 
+// LoadBalancer returns a LoadBalancer client.
+func (f azureFactory) LoadBalancer() (LoadBalancer, error) {
+	return NewLoadBalancerClient(*f.auth, f.tokenCredential, DefaultAzureClientOpts())
+}
+
 // AvailabilitySet returns an AvailabilitySet client.
 func (f azureFactory) AvailabilitySet() (AvailabilitySet, error) {
 	return NewAvailabilitySetClient(*f.auth)
@@ -164,11 +174,46 @@ func (f azureFactory) ManagedUserIdentity() (ManagedUserIdentity, error) {
 	return NewManagedUserIdentityClient(*f.auth)
 }
 
+// ManagementLock returns a ManagementLock client.
+func (f azureFactory) ManagementLock() (ManagementLock, error) {
+	return NewManagementLockClient(*f.auth)
+}
+
+// RoleAssignment returns a RoleAssignment client.
+func (f azureFactory) RoleAssignment() (RoleAssignment, error) {
+	return NewRoleAssignmentClient(*f.auth)
+}
+
 // VirtualMachineImages returns a VirtualMachineImages client.
 func (f azureFactory) VirtualMachineImages() (VirtualMachineImages, error) {
 	return NewVirtualMachineImagesClient(*f.auth)
 }
 
+// ProximityPlacementGroup returns a ProximityPlacementGroup client.
+func (f azureFactory) ProximityPlacementGroup() (ProximityPlacementGroup, error) {
+	return NewProximityPlacementGroupClient(*f.auth)
+}
+
+// DedicatedHostGroup returns a DedicatedHostGroup client.
+func (f azureFactory) DedicatedHostGroup() (DedicatedHostGroup, error) {
+	return NewDedicatedHostGroupClient(*f.auth)
+}
+
+// DedicatedHost returns a DedicatedHost client.
+func (f azureFactory) DedicatedHost() (DedicatedHost, error) {
+	return NewDedicatedHostClient(*f.auth)
+}
+
+// CapacityReservationGroup returns a CapacityReservationGroup client.
+func (f azureFactory) CapacityReservationGroup() (CapacityReservationGroup, error) {
+	return NewCapacityReservationGroupClient(*f.auth)
+}
+
+// CapacityReservation returns a CapacityReservation client.
+func (f azureFactory) CapacityReservation() (CapacityReservation, error) {
+	return NewCapacityReservationClient(*f.auth)
+}
+
 // NewBlobStorageClient reads the secret from the passed reference and return an Azure (blob) storage client.
 func NewBlobStorageClient(ctx context.Context, c client.Client, secretRef corev1.SecretReference) (Storage, error) {
 	serviceURL, err := newStorageClient(ctx, c, &secretRef)
@@ -78,6 +78,11 @@ type ContainerDeleteFunc[T any] interface {
 	Delete(ctx context.Context, container string) error
 }
 
+// ContainerListResourcesFunc lists all resources that are placed inside a container resource.
+type ContainerListResourcesFunc[T any] interface {
+	ListResources(ctx context.Context, container string) ([]*T, error)
+}
+
 // ContainerCheckExistenceFunc checks if the container resource exists in the infrastructure.
 type ContainerCheckExistenceFunc[T any] interface {
 	CheckExistence(ctx context.Context, container string) (bool, error)
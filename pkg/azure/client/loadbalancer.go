@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ LoadBalancer = &LoadBalancerClient{}
+
+// LoadBalancerClient is an implementation of LoadBalancer for a load balancer k8sClient.
+type LoadBalancerClient struct {
+	client *armnetwork.LoadBalancersClient
+}
+
+// NewLoadBalancerClient creates a new LoadBalancer client.
+func NewLoadBalancerClient(auth internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*LoadBalancerClient, error) {
+	client, err := armnetwork.NewLoadBalancersClient(auth.SubscriptionID, tc, opts)
+	return &LoadBalancerClient{client}, err
+}
+
+// List returns all load balancers in the given resource group.
+func (c *LoadBalancerClient) List(ctx context.Context, resourceGroupName string) ([]*armnetwork.LoadBalancer, error) {
+	pager := c.client.NewListPager(resourceGroupName, nil)
+	var lbs []*armnetwork.LoadBalancer
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbs = append(lbs, res.LoadBalancerListResult.Value...)
+	}
+	return lbs, nil
+}
+
+// Delete deletes the load balancer with the given name.
+func (c *LoadBalancerClient) Delete(ctx context.Context, resourceGroupName, name string) error {
+	poller, err := c.client.BeginDelete(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return FilterNotFoundError(err)
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
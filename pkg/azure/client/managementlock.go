@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ ManagementLock = &ManagementLockClient{}
+
+// ManagementLockClient is an implementation of ManagementLock for management locks.
+type ManagementLockClient struct {
+	client locks.ManagementLocksClient
+}
+
+// NewManagementLockClient creates a new ManagementLockClient.
+func NewManagementLockClient(auth internal.ClientAuth) (*ManagementLockClient, error) {
+	locksClient := locks.NewManagementLocksClient(auth.SubscriptionID)
+	authorizer, err := getAuthorizer(auth.TenantID, auth.ClientID, auth.ClientSecret)
+	locksClient.Authorizer = authorizer
+	return &ManagementLockClient{locksClient}, err
+}
+
+// CreateOrUpdate creates or updates the lock with the given name on the scope.
+func (c *ManagementLockClient) CreateOrUpdate(ctx context.Context, scope, lockName string, lock locks.ManagementLockObject) (*locks.ManagementLockObject, error) {
+	res, err := c.client.CreateOrUpdateByScope(ctx, scope, lockName, lock)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Delete deletes the lock with the given name from the scope if it exists.
+func (c *ManagementLockClient) Delete(ctx context.Context, scope, lockName string) error {
+	_, err := c.client.DeleteByScope(ctx, scope, lockName)
+	return FilterNotFoundError(err)
+}
+
+// List lists all locks that apply to the scope. If the scope does not exist, an empty list is returned.
+func (c *ManagementLockClient) List(ctx context.Context, scope string) ([]locks.ManagementLockObject, error) {
+	var res []locks.ManagementLockObject
+	it, err := c.client.ListByScopeComplete(ctx, scope, "")
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	for it.NotDone() {
+		res = append(res, it.Value())
+		if err := it.NextWithContext(ctx); err != nil {
+			return nil, FilterNotFoundError(err)
+		}
+	}
+	return res, nil
+}
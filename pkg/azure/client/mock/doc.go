@@ -2,6 +2,6 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-//go:generate mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity
+//go:generate mockgen -package client -destination=mocks.go github.com/gardener/gardener-extension-provider-azure/pkg/azure/client DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,LoadBalancer,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity,ManagementLock,RoleAssignment,ProximityPlacementGroup,DedicatedHostGroup,DedicatedHost,ApplicationSecurityGroup,CapacityReservationGroup,CapacityReservation
 
 package client
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity)
+// Source: github.com/gardener/gardener-extension-provider-azure/pkg/azure/client (interfaces: DNSZone,DNSRecordSet,Subnet,Factory,ResourceGroup,VirtualNetwork,RouteTables,NatGateway,LoadBalancer,PublicIP,AvailabilitySet,NetworkSecurityGroup,ManagedUserIdentity,ManagementLock,RoleAssignment,ProximityPlacementGroup,DedicatedHostGroup,DedicatedHost,ApplicationSecurityGroup,CapacityReservationGroup,CapacityReservation)
 
 // Package client is a generated GoMock package.
 package client
@@ -12,6 +12,8 @@ import (
 	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	armresources "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	msi "github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	authorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	locks "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	client "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 	internal "github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 	gomock "go.uber.org/mock/gomock"
@@ -211,6 +213,21 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// ApplicationSecurityGroup mocks base method.
+func (m *MockFactory) ApplicationSecurityGroup() (client.ApplicationSecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationSecurityGroup")
+	ret0, _ := ret[0].(client.ApplicationSecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplicationSecurityGroup indicates an expected call of ApplicationSecurityGroup.
+func (mr *MockFactoryMockRecorder) ApplicationSecurityGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationSecurityGroup", reflect.TypeOf((*MockFactory)(nil).ApplicationSecurityGroup))
+}
+
 // Auth mocks base method.
 func (m *MockFactory) Auth() *internal.ClientAuth {
 	m.ctrl.T.Helper()
@@ -240,6 +257,36 @@ func (mr *MockFactoryMockRecorder) AvailabilitySet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailabilitySet", reflect.TypeOf((*MockFactory)(nil).AvailabilitySet))
 }
 
+// CapacityReservation mocks base method.
+func (m *MockFactory) CapacityReservation() (client.CapacityReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CapacityReservation")
+	ret0, _ := ret[0].(client.CapacityReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CapacityReservation indicates an expected call of CapacityReservation.
+func (mr *MockFactoryMockRecorder) CapacityReservation() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CapacityReservation", reflect.TypeOf((*MockFactory)(nil).CapacityReservation))
+}
+
+// CapacityReservationGroup mocks base method.
+func (m *MockFactory) CapacityReservationGroup() (client.CapacityReservationGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CapacityReservationGroup")
+	ret0, _ := ret[0].(client.CapacityReservationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CapacityReservationGroup indicates an expected call of CapacityReservationGroup.
+func (mr *MockFactoryMockRecorder) CapacityReservationGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CapacityReservationGroup", reflect.TypeOf((*MockFactory)(nil).CapacityReservationGroup))
+}
+
 // DNSRecordSet mocks base method.
 func (m *MockFactory) DNSRecordSet() (client.DNSRecordSet, error) {
 	m.ctrl.T.Helper()
@@ -270,6 +317,36 @@ func (mr *MockFactoryMockRecorder) DNSZone() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNSZone", reflect.TypeOf((*MockFactory)(nil).DNSZone))
 }
 
+// DedicatedHost mocks base method.
+func (m *MockFactory) DedicatedHost() (client.DedicatedHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatedHost")
+	ret0, _ := ret[0].(client.DedicatedHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatedHost indicates an expected call of DedicatedHost.
+func (mr *MockFactoryMockRecorder) DedicatedHost() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedHost", reflect.TypeOf((*MockFactory)(nil).DedicatedHost))
+}
+
+// DedicatedHostGroup mocks base method.
+func (m *MockFactory) DedicatedHostGroup() (client.DedicatedHostGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatedHostGroup")
+	ret0, _ := ret[0].(client.DedicatedHostGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatedHostGroup indicates an expected call of DedicatedHostGroup.
+func (mr *MockFactoryMockRecorder) DedicatedHostGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatedHostGroup", reflect.TypeOf((*MockFactory)(nil).DedicatedHostGroup))
+}
+
 // Disk mocks base method.
 func (m *MockFactory) Disk() (client.Disk, error) {
 	m.ctrl.T.Helper()
@@ -300,6 +377,21 @@ func (mr *MockFactoryMockRecorder) Group() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Group", reflect.TypeOf((*MockFactory)(nil).Group))
 }
 
+// LoadBalancer mocks base method.
+func (m *MockFactory) LoadBalancer() (client.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancer")
+	ret0, _ := ret[0].(client.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancer indicates an expected call of LoadBalancer.
+func (mr *MockFactoryMockRecorder) LoadBalancer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MockFactory)(nil).LoadBalancer))
+}
+
 // ManagedUserIdentity mocks base method.
 func (m *MockFactory) ManagedUserIdentity() (client.ManagedUserIdentity, error) {
 	m.ctrl.T.Helper()
@@ -315,6 +407,21 @@ func (mr *MockFactoryMockRecorder) ManagedUserIdentity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedUserIdentity", reflect.TypeOf((*MockFactory)(nil).ManagedUserIdentity))
 }
 
+// ManagementLock mocks base method.
+func (m *MockFactory) ManagementLock() (client.ManagementLock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManagementLock")
+	ret0, _ := ret[0].(client.ManagementLock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManagementLock indicates an expected call of ManagementLock.
+func (mr *MockFactoryMockRecorder) ManagementLock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagementLock", reflect.TypeOf((*MockFactory)(nil).ManagementLock))
+}
+
 // NatGateway mocks base method.
 func (m *MockFactory) NatGateway() (client.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +467,21 @@ func (mr *MockFactoryMockRecorder) NetworkSecurityGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkSecurityGroup", reflect.TypeOf((*MockFactory)(nil).NetworkSecurityGroup))
 }
 
+// ProximityPlacementGroup mocks base method.
+func (m *MockFactory) ProximityPlacementGroup() (client.ProximityPlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProximityPlacementGroup")
+	ret0, _ := ret[0].(client.ProximityPlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProximityPlacementGroup indicates an expected call of ProximityPlacementGroup.
+func (mr *MockFactoryMockRecorder) ProximityPlacementGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProximityPlacementGroup", reflect.TypeOf((*MockFactory)(nil).ProximityPlacementGroup))
+}
+
 // PublicIP mocks base method.
 func (m *MockFactory) PublicIP() (client.PublicIP, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +497,21 @@ func (mr *MockFactoryMockRecorder) PublicIP() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockFactory)(nil).PublicIP))
 }
 
+// RoleAssignment mocks base method.
+func (m *MockFactory) RoleAssignment() (client.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleAssignment")
+	ret0, _ := ret[0].(client.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleAssignment indicates an expected call of RoleAssignment.
+func (mr *MockFactoryMockRecorder) RoleAssignment() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleAssignment", reflect.TypeOf((*MockFactory)(nil).RoleAssignment))
+}
+
 // RouteTables mocks base method.
 func (m *MockFactory) RouteTables() (client.RouteTables, error) {
 	m.ctrl.T.Helper()
@@ -562,6 +699,21 @@ func (mr *MockResourceGroupMockRecorder) Get(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockResourceGroup)(nil).Get), arg0, arg1)
 }
 
+// ListResources mocks base method.
+func (m *MockResourceGroup) ListResources(arg0 context.Context, arg1 string) ([]*armresources.GenericResourceExpanded, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResources", arg0, arg1)
+	ret0, _ := ret[0].([]*armresources.GenericResourceExpanded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResources indicates an expected call of ListResources.
+func (mr *MockResourceGroupMockRecorder) ListResources(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResources", reflect.TypeOf((*MockResourceGroup)(nil).ListResources), arg0, arg1)
+}
+
 // MockVirtualNetwork is a mock of VirtualNetwork interface.
 type MockVirtualNetwork struct {
 	ctrl     *gomock.Controller
@@ -778,6 +930,58 @@ func (mr *MockNatGatewayMockRecorder) List(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNatGateway)(nil).List), arg0, arg1)
 }
 
+// MockLoadBalancer is a mock of LoadBalancer interface.
+type MockLoadBalancer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancerMockRecorder
+}
+
+// MockLoadBalancerMockRecorder is the mock recorder for MockLoadBalancer.
+type MockLoadBalancerMockRecorder struct {
+	mock *MockLoadBalancer
+}
+
+// NewMockLoadBalancer creates a new mock instance.
+func NewMockLoadBalancer(ctrl *gomock.Controller) *MockLoadBalancer {
+	mock := &MockLoadBalancer{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancer) EXPECT() *MockLoadBalancerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancer) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancerMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancer)(nil).Delete), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancer) List(arg0 context.Context, arg1 string) ([]*armnetwork.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]*armnetwork.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancerMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancer)(nil).List), arg0, arg1)
+}
+
 // MockPublicIP is a mock of PublicIP interface.
 type MockPublicIP struct {
 	ctrl     *gomock.Controller
@@ -1017,6 +1221,35 @@ func (m *MockManagedUserIdentity) EXPECT() *MockManagedUserIdentityMockRecorder
 	return m.recorder
 }
 
+// CreateOrUpdate mocks base method.
+func (m *MockManagedUserIdentity) CreateOrUpdate(arg0 context.Context, arg1, arg2 string, arg3 msi.Identity) (*msi.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*msi.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockManagedUserIdentityMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockManagedUserIdentity)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockManagedUserIdentity) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManagedUserIdentityMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManagedUserIdentity)(nil).Delete), arg0, arg1, arg2)
+}
+
 // Get mocks base method.
 func (m *MockManagedUserIdentity) Get(arg0 context.Context, arg1, arg2 string) (*msi.Identity, error) {
 	m.ctrl.T.Helper()
@@ -1031,3 +1264,423 @@ func (mr *MockManagedUserIdentityMockRecorder) Get(arg0, arg1, arg2 interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockManagedUserIdentity)(nil).Get), arg0, arg1, arg2)
 }
+
+// MockManagementLock is a mock of ManagementLock interface.
+type MockManagementLock struct {
+	ctrl     *gomock.Controller
+	recorder *MockManagementLockMockRecorder
+}
+
+// MockManagementLockMockRecorder is the mock recorder for MockManagementLock.
+type MockManagementLockMockRecorder struct {
+	mock *MockManagementLock
+}
+
+// NewMockManagementLock creates a new mock instance.
+func NewMockManagementLock(ctrl *gomock.Controller) *MockManagementLock {
+	mock := &MockManagementLock{ctrl: ctrl}
+	mock.recorder = &MockManagementLockMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockManagementLock) EXPECT() *MockManagementLockMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockManagementLock) CreateOrUpdate(arg0 context.Context, arg1, arg2 string, arg3 locks.ManagementLockObject) (*locks.ManagementLockObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*locks.ManagementLockObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockManagementLockMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockManagementLock)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockManagementLock) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockManagementLockMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockManagementLock)(nil).Delete), arg0, arg1, arg2)
+}
+
+// List mocks base method.
+func (m *MockManagementLock) List(arg0 context.Context, arg1 string) ([]locks.ManagementLockObject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]locks.ManagementLockObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockManagementLockMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockManagementLock)(nil).List), arg0, arg1)
+}
+
+// MockRoleAssignment is a mock of RoleAssignment interface.
+type MockRoleAssignment struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleAssignmentMockRecorder
+}
+
+// MockRoleAssignmentMockRecorder is the mock recorder for MockRoleAssignment.
+type MockRoleAssignmentMockRecorder struct {
+	mock *MockRoleAssignment
+}
+
+// NewMockRoleAssignment creates a new mock instance.
+func NewMockRoleAssignment(ctrl *gomock.Controller) *MockRoleAssignment {
+	mock := &MockRoleAssignment{ctrl: ctrl}
+	mock.recorder = &MockRoleAssignmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleAssignment) EXPECT() *MockRoleAssignmentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRoleAssignment) Create(arg0 context.Context, arg1, arg2 string, arg3 authorization.RoleAssignmentCreateParameters) (*authorization.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*authorization.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRoleAssignmentMockRecorder) Create(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRoleAssignment)(nil).Create), arg0, arg1, arg2, arg3)
+}
+
+// DeleteByID mocks base method.
+func (m *MockRoleAssignment) DeleteByID(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockRoleAssignmentMockRecorder) DeleteByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockRoleAssignment)(nil).DeleteByID), arg0, arg1)
+}
+
+// ListForPrincipal mocks base method.
+func (m *MockRoleAssignment) ListForPrincipal(arg0 context.Context, arg1 string) ([]authorization.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListForPrincipal", arg0, arg1)
+	ret0, _ := ret[0].([]authorization.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListForPrincipal indicates an expected call of ListForPrincipal.
+func (mr *MockRoleAssignmentMockRecorder) ListForPrincipal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListForPrincipal", reflect.TypeOf((*MockRoleAssignment)(nil).ListForPrincipal), arg0, arg1)
+}
+
+// MockProximityPlacementGroup is a mock of ProximityPlacementGroup interface.
+type MockProximityPlacementGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockProximityPlacementGroupMockRecorder
+}
+
+// MockProximityPlacementGroupMockRecorder is the mock recorder for MockProximityPlacementGroup.
+type MockProximityPlacementGroupMockRecorder struct {
+	mock *MockProximityPlacementGroup
+}
+
+// NewMockProximityPlacementGroup creates a new mock instance.
+func NewMockProximityPlacementGroup(ctrl *gomock.Controller) *MockProximityPlacementGroup {
+	mock := &MockProximityPlacementGroup{ctrl: ctrl}
+	mock.recorder = &MockProximityPlacementGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProximityPlacementGroup) EXPECT() *MockProximityPlacementGroupMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockProximityPlacementGroup) CreateOrUpdate(arg0 context.Context, arg1, arg2 string, arg3 armcompute.ProximityPlacementGroup) (*armcompute.ProximityPlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.ProximityPlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockProximityPlacementGroupMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockProximityPlacementGroup)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockProximityPlacementGroup) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockProximityPlacementGroupMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProximityPlacementGroup)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockProximityPlacementGroup) Get(arg0 context.Context, arg1, arg2 string) (*armcompute.ProximityPlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armcompute.ProximityPlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockProximityPlacementGroupMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProximityPlacementGroup)(nil).Get), arg0, arg1, arg2)
+}
+
+// MockDedicatedHostGroup is a mock of DedicatedHostGroup interface.
+type MockDedicatedHostGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockDedicatedHostGroupMockRecorder
+}
+
+// MockDedicatedHostGroupMockRecorder is the mock recorder for MockDedicatedHostGroup.
+type MockDedicatedHostGroupMockRecorder struct {
+	mock *MockDedicatedHostGroup
+}
+
+// NewMockDedicatedHostGroup creates a new mock instance.
+func NewMockDedicatedHostGroup(ctrl *gomock.Controller) *MockDedicatedHostGroup {
+	mock := &MockDedicatedHostGroup{ctrl: ctrl}
+	mock.recorder = &MockDedicatedHostGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDedicatedHostGroup) EXPECT() *MockDedicatedHostGroupMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDedicatedHostGroup) Get(arg0 context.Context, arg1, arg2 string) (*armcompute.DedicatedHostGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armcompute.DedicatedHostGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDedicatedHostGroupMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDedicatedHostGroup)(nil).Get), arg0, arg1, arg2)
+}
+
+// MockDedicatedHost is a mock of DedicatedHost interface.
+type MockDedicatedHost struct {
+	ctrl     *gomock.Controller
+	recorder *MockDedicatedHostMockRecorder
+}
+
+// MockDedicatedHostMockRecorder is the mock recorder for MockDedicatedHost.
+type MockDedicatedHostMockRecorder struct {
+	mock *MockDedicatedHost
+}
+
+// NewMockDedicatedHost creates a new mock instance.
+func NewMockDedicatedHost(ctrl *gomock.Controller) *MockDedicatedHost {
+	mock := &MockDedicatedHost{ctrl: ctrl}
+	mock.recorder = &MockDedicatedHostMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDedicatedHost) EXPECT() *MockDedicatedHostMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockDedicatedHost) Get(arg0 context.Context, arg1, arg2, arg3 string, arg4 *armcompute.InstanceViewTypes) (*armcompute.DedicatedHost, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*armcompute.DedicatedHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDedicatedHostMockRecorder) Get(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDedicatedHost)(nil).Get), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockApplicationSecurityGroup is a mock of ApplicationSecurityGroup interface.
+type MockApplicationSecurityGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationSecurityGroupMockRecorder
+}
+
+// MockApplicationSecurityGroupMockRecorder is the mock recorder for MockApplicationSecurityGroup.
+type MockApplicationSecurityGroupMockRecorder struct {
+	mock *MockApplicationSecurityGroup
+}
+
+// NewMockApplicationSecurityGroup creates a new mock instance.
+func NewMockApplicationSecurityGroup(ctrl *gomock.Controller) *MockApplicationSecurityGroup {
+	mock := &MockApplicationSecurityGroup{ctrl: ctrl}
+	mock.recorder = &MockApplicationSecurityGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationSecurityGroup) EXPECT() *MockApplicationSecurityGroupMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MockApplicationSecurityGroup) CreateOrUpdate(arg0 context.Context, arg1, arg2 string, arg3 armnetwork.ApplicationSecurityGroup) (*armnetwork.ApplicationSecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armnetwork.ApplicationSecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockApplicationSecurityGroupMockRecorder) CreateOrUpdate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockApplicationSecurityGroup)(nil).CreateOrUpdate), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method.
+func (m *MockApplicationSecurityGroup) Delete(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockApplicationSecurityGroupMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApplicationSecurityGroup)(nil).Delete), arg0, arg1, arg2)
+}
+
+// Get mocks base method.
+func (m *MockApplicationSecurityGroup) Get(arg0 context.Context, arg1, arg2 string) (*armnetwork.ApplicationSecurityGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armnetwork.ApplicationSecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockApplicationSecurityGroupMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockApplicationSecurityGroup)(nil).Get), arg0, arg1, arg2)
+}
+
+// MockCapacityReservationGroup is a mock of CapacityReservationGroup interface.
+type MockCapacityReservationGroup struct {
+	ctrl     *gomock.Controller
+	recorder *MockCapacityReservationGroupMockRecorder
+}
+
+// MockCapacityReservationGroupMockRecorder is the mock recorder for MockCapacityReservationGroup.
+type MockCapacityReservationGroupMockRecorder struct {
+	mock *MockCapacityReservationGroup
+}
+
+// NewMockCapacityReservationGroup creates a new mock instance.
+func NewMockCapacityReservationGroup(ctrl *gomock.Controller) *MockCapacityReservationGroup {
+	mock := &MockCapacityReservationGroup{ctrl: ctrl}
+	mock.recorder = &MockCapacityReservationGroupMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCapacityReservationGroup) EXPECT() *MockCapacityReservationGroupMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCapacityReservationGroup) Get(arg0 context.Context, arg1, arg2 string) (*armcompute.CapacityReservationGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*armcompute.CapacityReservationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCapacityReservationGroupMockRecorder) Get(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCapacityReservationGroup)(nil).Get), arg0, arg1, arg2)
+}
+
+// MockCapacityReservation is a mock of CapacityReservation interface.
+type MockCapacityReservation struct {
+	ctrl     *gomock.Controller
+	recorder *MockCapacityReservationMockRecorder
+}
+
+// MockCapacityReservationMockRecorder is the mock recorder for MockCapacityReservation.
+type MockCapacityReservationMockRecorder struct {
+	mock *MockCapacityReservation
+}
+
+// NewMockCapacityReservation creates a new mock instance.
+func NewMockCapacityReservation(ctrl *gomock.Controller) *MockCapacityReservation {
+	mock := &MockCapacityReservation{ctrl: ctrl}
+	mock.recorder = &MockCapacityReservationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCapacityReservation) EXPECT() *MockCapacityReservationMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockCapacityReservation) Get(arg0 context.Context, arg1, arg2, arg3 string) (*armcompute.CapacityReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*armcompute.CapacityReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCapacityReservationMockRecorder) Get(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCapacityReservation)(nil).Get), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ ProximityPlacementGroup = &ProximityPlacementGroupClient{}
+
+// ProximityPlacementGroupClient is an implementation of ProximityPlacementGroup for a proximity placement group k8sClient.
+type ProximityPlacementGroupClient struct {
+	client *armcompute.ProximityPlacementGroupsClient
+}
+
+// NewProximityPlacementGroupClient creates a new ProximityPlacementGroupClient.
+func NewProximityPlacementGroupClient(auth internal.ClientAuth) (*ProximityPlacementGroupClient, error) {
+	cred, err := auth.GetAzClientCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client, err := armcompute.NewProximityPlacementGroupsClient(auth.SubscriptionID, cred, nil)
+	return &ProximityPlacementGroupClient{client}, err
+}
+
+// CreateOrUpdate creates or updates a proximity placement group.
+func (c *ProximityPlacementGroupClient) CreateOrUpdate(ctx context.Context, resourceGroupName, proximityPlacementGroupName string, parameters armcompute.ProximityPlacementGroup) (*armcompute.ProximityPlacementGroup, error) {
+	res, err := c.client.CreateOrUpdate(ctx, resourceGroupName, proximityPlacementGroupName, parameters, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create proximity placement group: %v", err)
+	}
+	return &res.ProximityPlacementGroup, nil
+}
+
+// Get returns the proximity placement group for the given resource group and proximity placement group name.
+func (c *ProximityPlacementGroupClient) Get(ctx context.Context, resourceGroupName, proximityPlacementGroupName string) (*armcompute.ProximityPlacementGroup, error) {
+	res, err := c.client.Get(ctx, resourceGroupName, proximityPlacementGroupName, nil)
+	if err != nil {
+		return nil, FilterNotFoundError(err)
+	}
+	return &res.ProximityPlacementGroup, err
+}
+
+// Delete deletes the proximity placement group for the given resource group and proximity placement group name.
+func (c *ProximityPlacementGroupClient) Delete(ctx context.Context, resourceGroupName, proximityPlacementGroupName string) error {
+	_, err := c.client.Delete(ctx, resourceGroupName, proximityPlacementGroupName, nil)
+	return FilterNotFoundError(err)
+}
@@ -18,13 +18,18 @@ var _ ResourceGroup = &ResourceGroupClient{}
 
 // ResourceGroupClient is a client for resource groups.
 type ResourceGroupClient struct {
-	client *armresources.ResourceGroupsClient
+	client          *armresources.ResourceGroupsClient
+	resourcesClient *armresources.Client
 }
 
 // NewResourceGroupsClient creates a new ResourceGroupClient
 func NewResourceGroupsClient(auth *internal.ClientAuth, tc azcore.TokenCredential, opts *arm.ClientOptions) (*ResourceGroupClient, error) {
 	client, err := armresources.NewResourceGroupsClient(auth.SubscriptionID, tc, opts)
-	return &ResourceGroupClient{client}, err
+	if err != nil {
+		return nil, err
+	}
+	resourcesClient, err := armresources.NewClient(auth.SubscriptionID, tc, opts)
+	return &ResourceGroupClient{client, resourcesClient}, err
 }
 
 // Get gets a resource group.
@@ -67,3 +72,17 @@ func (c *ResourceGroupClient) CheckExistence(ctx context.Context, resourceGroupN
 	}
 	return res.Success, err
 }
+
+// ListResources lists all resources in the resource group. If the resource group does not exist, an empty list is returned.
+func (c *ResourceGroupClient) ListResources(ctx context.Context, resourceGroupName string) ([]*armresources.GenericResourceExpanded, error) {
+	pager := c.resourcesClient.NewListByResourceGroupPager(resourceGroupName, nil)
+	var resources []*armresources.GenericResourceExpanded
+	for pager.More() {
+		res, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, FilterNotFoundError(err)
+		}
+		resources = append(resources, res.ResourceListResult.Value...)
+	}
+	return resources, nil
+}
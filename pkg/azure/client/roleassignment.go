@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ RoleAssignment = &RoleAssignmentClient{}
+
+// RoleAssignmentClient is an implementation of RoleAssignment for a role assignment k8sClient.
+type RoleAssignmentClient struct {
+	client authorization.RoleAssignmentsClient
+}
+
+// NewRoleAssignmentClient creates a new RoleAssignmentClient.
+func NewRoleAssignmentClient(auth internal.ClientAuth) (*RoleAssignmentClient, error) {
+	raClient := authorization.NewRoleAssignmentsClient(auth.SubscriptionID)
+	authorizer, err := getAuthorizer(auth.TenantID, auth.ClientID, auth.ClientSecret)
+	raClient.Authorizer = authorizer
+	return &RoleAssignmentClient{raClient}, err
+}
+
+// Create creates a role assignment with the given name on the scope. Role assignments cannot be updated, hence nil is
+// returned without an error if the role is already assigned to the principal on the scope.
+func (c *RoleAssignmentClient) Create(ctx context.Context, scope, name string, params authorization.RoleAssignmentCreateParameters) (*authorization.RoleAssignment, error) {
+	res, err := c.client.Create(ctx, scope, name, params)
+	if err != nil {
+		if isAzureAPIStatusError(err, http.StatusConflict) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteByID deletes the role assignment with the given ID if it exists.
+func (c *RoleAssignmentClient) DeleteByID(ctx context.Context, id string) error {
+	_, err := c.client.DeleteByID(ctx, id, "")
+	return FilterNotFoundError(err)
+}
+
+// ListForPrincipal lists all role assignments of the principal in the subscription.
+func (c *RoleAssignmentClient) ListForPrincipal(ctx context.Context, principalID string) ([]authorization.RoleAssignment, error) {
+	var res []authorization.RoleAssignment
+	it, err := c.client.ListComplete(ctx, fmt.Sprintf("principalId eq '%s'", principalID), "")
+	if err != nil {
+		return nil, err
+	}
+	for it.NotDone() {
+		res = append(res, it.Value())
+		if err := it.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
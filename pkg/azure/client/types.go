@@ -12,6 +12,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-03-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 )
@@ -29,14 +31,23 @@ type Factory interface {
 	Disk() (Disk, error)
 	Group() (ResourceGroup, error)
 	NetworkSecurityGroup() (NetworkSecurityGroup, error)
+	ApplicationSecurityGroup() (ApplicationSecurityGroup, error)
 	Subnet() (Subnet, error)
 	PublicIP() (PublicIP, error)
 	Vnet() (VirtualNetwork, error)
 	RouteTables() (RouteTables, error)
 	NatGateway() (NatGateway, error)
+	LoadBalancer() (LoadBalancer, error)
 	AvailabilitySet() (AvailabilitySet, error)
 	ManagedUserIdentity() (ManagedUserIdentity, error)
+	ManagementLock() (ManagementLock, error)
+	RoleAssignment() (RoleAssignment, error)
 	VirtualMachineImages() (VirtualMachineImages, error)
+	ProximityPlacementGroup() (ProximityPlacementGroup, error)
+	DedicatedHostGroup() (DedicatedHostGroup, error)
+	DedicatedHost() (DedicatedHost, error)
+	CapacityReservationGroup() (CapacityReservationGroup, error)
+	CapacityReservation() (CapacityReservation, error)
 }
 
 // ResourceGroup represents an Azure ResourceGroup k8sClient.
@@ -45,6 +56,7 @@ type ResourceGroup interface {
 	ContainerDeleteFunc[armresources.ResourceGroup]
 	ContainerGetFunc[armresources.ResourceGroup]
 	ContainerCheckExistenceFunc[armresources.ResourceGroup]
+	ContainerListResourcesFunc[armresources.GenericResourceExpanded]
 }
 
 // AvailabilitySet is an interface for the Azure AvailabilitySet service.
@@ -54,6 +66,35 @@ type AvailabilitySet interface {
 	DeleteFunc[armcompute.AvailabilitySet]
 }
 
+// ProximityPlacementGroup is an interface for the Azure ProximityPlacementGroup service.
+type ProximityPlacementGroup interface {
+	GetFunc[armcompute.ProximityPlacementGroup]
+	CreateOrUpdateFunc[armcompute.ProximityPlacementGroup]
+	DeleteFunc[armcompute.ProximityPlacementGroup]
+}
+
+// DedicatedHostGroup is an interface for the Azure DedicatedHostGroup service.
+type DedicatedHostGroup interface {
+	GetFunc[armcompute.DedicatedHostGroup]
+}
+
+// DedicatedHost is an interface for the Azure DedicatedHost service. Dedicated hosts are addressed by the name of their
+// host group and their own name.
+type DedicatedHost interface {
+	SubResourceGetWithExpandFunc[armcompute.DedicatedHost, *armcompute.InstanceViewTypes]
+}
+
+// CapacityReservationGroup is an interface for the Azure CapacityReservationGroup service.
+type CapacityReservationGroup interface {
+	GetFunc[armcompute.CapacityReservationGroup]
+}
+
+// CapacityReservation is an interface for the Azure CapacityReservation service. Capacity reservations are addressed
+// by the name of their capacity reservation group and their own name.
+type CapacityReservation interface {
+	SubResourceGetFunc[armcompute.CapacityReservation]
+}
+
 // NatGateway is an interface for the Azure NatGateway service.
 type NatGateway interface {
 	CreateOrUpdateFunc[armnetwork.NatGateway]
@@ -62,6 +103,12 @@ type NatGateway interface {
 	DeleteFunc[armnetwork.NatGateway]
 }
 
+// LoadBalancer is an interface for the Azure LoadBalancer service.
+type LoadBalancer interface {
+	ListFunc[armnetwork.LoadBalancer]
+	DeleteFunc[armnetwork.LoadBalancer]
+}
+
 // RouteTables is a k8sClient for the Azure RouteTable service.
 type RouteTables interface {
 	CreateOrUpdateFunc[armnetwork.RouteTable]
@@ -72,6 +119,24 @@ type RouteTables interface {
 // ManagedUserIdentity is a k8sClient for the Azure Managed User Identity service.
 type ManagedUserIdentity interface {
 	GetFunc[msi.Identity]
+	CreateOrUpdateFunc[msi.Identity]
+	DeleteFunc[msi.Identity]
+}
+
+// RoleAssignment is a k8sClient for the Azure role assignments service. Role assignments are addressed by their fully
+// qualified ID, which is composed of the scope they apply to and their name.
+type RoleAssignment interface {
+	Create(ctx context.Context, scope, name string, params authorization.RoleAssignmentCreateParameters) (*authorization.RoleAssignment, error)
+	DeleteByID(ctx context.Context, id string) error
+	ListForPrincipal(ctx context.Context, principalID string) ([]authorization.RoleAssignment, error)
+}
+
+// ManagementLock is a k8sClient for the Azure management locks service. Locks are addressed by the ID of the scope they
+// are placed on and their name.
+type ManagementLock interface {
+	CreateOrUpdate(ctx context.Context, scope, lockName string, lock locks.ManagementLockObject) (*locks.ManagementLockObject, error)
+	Delete(ctx context.Context, scope, lockName string) error
+	List(ctx context.Context, scope string) ([]locks.ManagementLockObject, error)
 }
 
 // Vmss represents an Azure virtual machine scale set k8sClient.
@@ -96,6 +161,13 @@ type NetworkSecurityGroup interface {
 	DeleteFunc[armnetwork.SecurityGroup]
 }
 
+// ApplicationSecurityGroup represents an Azure application security group k8sClient.
+type ApplicationSecurityGroup interface {
+	GetFunc[armnetwork.ApplicationSecurityGroup]
+	CreateOrUpdateFunc[armnetwork.ApplicationSecurityGroup]
+	DeleteFunc[armnetwork.ApplicationSecurityGroup]
+}
+
 // PublicIP represents an Azure Network Public IP k8sClient.
 type PublicIP interface {
 	GetWithExpandFunc[armnetwork.PublicIPAddress, *string]
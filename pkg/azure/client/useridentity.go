@@ -39,6 +39,21 @@ func (m *ManagedUserIdentityClient) Get(ctx context.Context, resourceGroup, id s
 	return &res, nil
 }
 
+// CreateOrUpdate creates or updates a Managed User Identity.
+func (m *ManagedUserIdentityClient) CreateOrUpdate(ctx context.Context, resourceGroup, name string, identity msi.Identity) (*msi.Identity, error) {
+	res, err := m.client.CreateOrUpdate(ctx, resourceGroup, name, identity)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Delete deletes a Managed User Identity if it exists.
+func (m *ManagedUserIdentityClient) Delete(ctx context.Context, resourceGroup, name string) error {
+	_, err := m.client.Delete(ctx, resourceGroup, name)
+	return FilterNotFoundError(err)
+}
+
 func getAuthorizer(tenantId, clientId, clientSecret string) (autorest.Authorizer, error) {
 	oauthConfig, err := adal.NewOAuthConfig(azureRest.PublicCloud.ActiveDirectoryEndpoint, tenantId)
 	if err != nil {
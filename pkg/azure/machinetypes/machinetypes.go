@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package machinetypes converts the virtual machine sizes of the Azure Resource SKUs API into the machine types of the
+// CloudProfileConfig.
+package machinetypes
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+)
+
+const (
+	resourceTypeVirtualMachines = "virtualMachines"
+
+	capabilityVCPUs                 = "vCPUs"
+	capabilityVCPUsAvailable        = "vCPUsAvailable"
+	capabilityMemoryGB              = "MemoryGB"
+	capabilityGPUs                  = "GPUs"
+	capabilityPremiumIO             = "PremiumIO"
+	capabilityAcceleratedNetworking = "AcceleratedNetworkingEnabled"
+	capabilityMaxNetworkInterfaces  = "MaxNetworkInterfaces"
+	capabilityCachedDiskBytes       = "CachedDiskBytes"
+	capabilityMaxResourceVolumeMB   = "MaxResourceVolumeMB"
+	capabilityUltraSSDAvailable     = "UltraSSDAvailable"
+	capabilityMaxDataDiskCount      = "MaxDataDiskCount"
+	capabilityEphemeralOSDisk       = "EphemeralOSDiskSupported"
+	capabilityCPUArchitectureType   = "CpuArchitectureType"
+	capabilityTrustedLaunchDisabled = "TrustedLaunchDisabled"
+	capabilityConfidentialComputing = "ConfidentialComputingType"
+)
+
+// architectures maps the CPU architecture types of the Resource SKUs API to the architectures of Gardener.
+var architectures = map[string]string{
+	"x64":   v1beta1constants.ArchitectureAMD64,
+	"arm64": v1beta1constants.ArchitectureARM64,
+}
+
+// FromResourceSKUs returns the machine types for the virtual machine sizes among the given resource SKUs which are
+// available in the given location, sorted by name.
+func FromResourceSKUs(skus []*armcompute.ResourceSKU, location string) []v1alpha1.MachineType {
+	var machineTypes []v1alpha1.MachineType
+	for _, sku := range skus {
+		if sku == nil || sku.Name == nil || pointer.StringDeref(sku.ResourceType, "") != resourceTypeVirtualMachines {
+			continue
+		}
+		if !availableInLocation(sku, location) {
+			continue
+		}
+		machineTypes = append(machineTypes, fromResourceSKU(sku, location))
+	}
+
+	sort.Slice(machineTypes, func(i, j int) bool { return machineTypes[i].Name < machineTypes[j].Name })
+	return machineTypes
+}
+
+func fromResourceSKU(sku *armcompute.ResourceSKU, location string) v1alpha1.MachineType {
+	capabilities := capabilitiesToMap(sku.Capabilities)
+	machineType := v1alpha1.MachineType{Name: *sku.Name}
+
+	// vCPUsAvailable is lower than vCPUs for constrained vCPU sizes and is what the operating system sees.
+	cpu, ok := parseInt(capabilities[capabilityVCPUsAvailable])
+	if !ok {
+		cpu, ok = parseInt(capabilities[capabilityVCPUs])
+	}
+	if ok {
+		machineType.CPU = resource.NewQuantity(cpu, resource.DecimalSI)
+	}
+	if memory, err := strconv.ParseFloat(capabilities[capabilityMemoryGB], 64); err == nil {
+		machineType.Memory = resource.NewQuantity(int64(memory*1024*1024*1024), resource.BinarySI)
+	}
+	if gpus, ok := parseInt(capabilities[capabilityGPUs]); ok && gpus > 0 {
+		machineType.GPU = resource.NewQuantity(gpus, resource.DecimalSI)
+	}
+
+	if value, ok := capabilities[capabilityAcceleratedNetworking]; ok {
+		machineType.AcceleratedNetworking = pointer.Bool(strings.EqualFold(value, "true"))
+	}
+	if value, ok := capabilities[capabilityPremiumIO]; ok {
+		machineType.PremiumIO = pointer.Bool(strings.EqualFold(value, "true"))
+	}
+	if count, ok := parseInt(capabilities[capabilityMaxNetworkInterfaces]); ok {
+		machineType.MaxNetworkInterfaces = pointer.Int32(int32(count))
+	}
+	if bytes, ok := parseInt(capabilities[capabilityCachedDiskBytes]); ok && bytes > 0 {
+		machineType.CacheDiskSizeGB = pointer.Int32(int32(bytes / (1024 * 1024 * 1024)))
+	}
+	if megabytes, ok := parseInt(capabilities[capabilityMaxResourceVolumeMB]); ok && megabytes > 0 {
+		machineType.ResourceDiskSizeGB = pointer.Int32(int32(megabytes / 1024))
+	}
+
+	if value, ok := capabilities[capabilityEphemeralOSDisk]; ok {
+		machineType.EphemeralOSDisk = pointer.Bool(strings.EqualFold(value, "true"))
+	}
+	if count, ok := parseInt(capabilities[capabilityMaxDataDiskCount]); ok {
+		machineType.MaxDataDisks = pointer.Int32(int32(count))
+	}
+	if architecture, ok := architectures[strings.ToLower(capabilities[capabilityCPUArchitectureType])]; ok {
+		machineType.Architectures = []string{architecture}
+	}
+	if !strings.EqualFold(capabilities[capabilityTrustedLaunchDisabled], "true") {
+		machineType.SecurityTypes = append(machineType.SecurityTypes, v1alpha1.SecurityTypeTrustedLaunch)
+	}
+	if capabilities[capabilityConfidentialComputing] != "" {
+		machineType.SecurityTypes = append(machineType.SecurityTypes, v1alpha1.SecurityTypeConfidentialVM)
+	}
+
+	// Zones and ultra disks are declared per location in the location info.
+	restrictedZones := sets.New[string]()
+	for _, restriction := range sku.Restrictions {
+		if restriction == nil || restriction.RestrictionInfo == nil || pointer.StringDeref((*string)(restriction.Type), "") != string(armcompute.ResourceSKURestrictionsTypeZone) {
+			continue
+		}
+		for _, zone := range restriction.RestrictionInfo.Zones {
+			if zone != nil {
+				restrictedZones.Insert(*zone)
+			}
+		}
+	}
+	for _, info := range sku.LocationInfo {
+		if info == nil || !strings.EqualFold(pointer.StringDeref(info.Location, ""), location) {
+			continue
+		}
+		zones := sets.New[string]()
+		for _, zone := range info.Zones {
+			if zone != nil && !restrictedZones.Has(*zone) {
+				zones.Insert(*zone)
+			}
+		}
+		if zones.Len() > 0 {
+			machineType.Zones = []v1alpha1.MachineTypeZones{{Region: location, Names: sets.List(zones)}}
+		}
+		for _, zoneDetails := range info.ZoneDetails {
+			if zoneDetails != nil && strings.EqualFold(capabilitiesToMap(zoneDetails.Capabilities)[capabilityUltraSSDAvailable], "true") {
+				machineType.UltraSSD = pointer.Bool(true)
+			}
+		}
+	}
+
+	return machineType
+}
+
+// availableInLocation checks that the SKU is offered in the given location and not restricted for the subscription.
+func availableInLocation(sku *armcompute.ResourceSKU, location string) bool {
+	offered := false
+	for _, l := range sku.Locations {
+		if l != nil && strings.EqualFold(*l, location) {
+			offered = true
+		}
+	}
+	if !offered {
+		return false
+	}
+
+	for _, restriction := range sku.Restrictions {
+		if restriction == nil || pointer.StringDeref((*string)(restriction.Type), "") != string(armcompute.ResourceSKURestrictionsTypeLocation) {
+			continue
+		}
+		for _, value := range restriction.Values {
+			if value != nil && strings.EqualFold(*value, location) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func capabilitiesToMap(capabilities []*armcompute.ResourceSKUCapabilities) map[string]string {
+	out := make(map[string]string, len(capabilities))
+	for _, capability := range capabilities {
+		if capability != nil && capability.Name != nil && capability.Value != nil {
+			out[*capability.Name] = *capability.Value
+		}
+	}
+	return out
+}
+
+func parseInt(value string) (int64, bool) {
+	i, err := strconv.ParseInt(value, 10, 64)
+	return i, err == nil
+}
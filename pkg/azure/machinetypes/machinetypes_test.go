@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package machinetypes_test
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	. "github.com/gardener/gardener-extension-provider-azure/pkg/azure/machinetypes"
+)
+
+var _ = Describe("MachineTypes", func() {
+	capabilities := func(keysAndValues ...string) []*armcompute.ResourceSKUCapabilities {
+		var out []*armcompute.ResourceSKUCapabilities
+		for i := 0; i < len(keysAndValues); i += 2 {
+			out = append(out, &armcompute.ResourceSKUCapabilities{Name: to.Ptr(keysAndValues[i]), Value: to.Ptr(keysAndValues[i+1])})
+		}
+		return out
+	}
+
+	Describe("#FromResourceSKUs", func() {
+		It("should convert the virtual machine sizes available in the location", func() {
+			skus := []*armcompute.ResourceSKU{
+				{
+					Name:         to.Ptr("Standard_NC6s_v3"),
+					ResourceType: to.Ptr("virtualMachines"),
+					Locations:    []*string{to.Ptr("WestEurope")},
+					Capabilities: capabilities(
+						"vCPUs", "6",
+						"MemoryGB", "112",
+						"GPUs", "1",
+						"PremiumIO", "True",
+						"AcceleratedNetworkingEnabled", "False",
+						"MaxNetworkInterfaces", "4",
+						"CachedDiskBytes", "394264576000",
+						"MaxResourceVolumeMB", "344064",
+						"MaxDataDiskCount", "24",
+						"EphemeralOSDiskSupported", "True",
+						"CpuArchitectureType", "x64",
+						"TrustedLaunchDisabled", "True",
+					),
+					LocationInfo: []*armcompute.ResourceSKULocationInfo{{
+						Location:    to.Ptr("WestEurope"),
+						Zones:       []*string{to.Ptr("3"), to.Ptr("1"), to.Ptr("2")},
+						ZoneDetails: []*armcompute.ResourceSKUZoneDetails{{Capabilities: capabilities("UltraSSDAvailable", "True")}},
+					}},
+					Restrictions: []*armcompute.ResourceSKURestrictions{{
+						Type:            to.Ptr(armcompute.ResourceSKURestrictionsTypeZone),
+						RestrictionInfo: &armcompute.ResourceSKURestrictionInfo{Zones: []*string{to.Ptr("2")}},
+					}},
+				},
+				{
+					Name:         to.Ptr("Standard_E4-2s_v5"),
+					ResourceType: to.Ptr("virtualMachines"),
+					Locations:    []*string{to.Ptr("westeurope")},
+					Capabilities: capabilities("vCPUs", "4", "vCPUsAvailable", "2", "MemoryGB", "0.75", "CpuArchitectureType", "Arm64", "ConfidentialComputingType", "SNP"),
+				},
+				{
+					Name:         to.Ptr("Standard_D2s_v5"),
+					ResourceType: to.Ptr("virtualMachines"),
+					Locations:    []*string{to.Ptr("westeurope")},
+					Restrictions: []*armcompute.ResourceSKURestrictions{{
+						Type:   to.Ptr(armcompute.ResourceSKURestrictionsTypeLocation),
+						Values: []*string{to.Ptr("westeurope")},
+					}},
+				},
+				{
+					Name:         to.Ptr("Standard_D4s_v5"),
+					ResourceType: to.Ptr("virtualMachines"),
+					Locations:    []*string{to.Ptr("northeurope")},
+				},
+				{
+					Name:         to.Ptr("Premium_LRS"),
+					ResourceType: to.Ptr("disks"),
+					Locations:    []*string{to.Ptr("westeurope")},
+				},
+			}
+
+			Expect(FromResourceSKUs(skus, "westeurope")).To(Equal([]v1alpha1.MachineType{
+				{
+					Name:          "Standard_E4-2s_v5",
+					CPU:           resource.NewQuantity(2, resource.DecimalSI),
+					Memory:        resource.NewQuantity(768*1024*1024, resource.BinarySI),
+					Architectures: []string{"arm64"},
+					SecurityTypes: []v1alpha1.SecurityType{v1alpha1.SecurityTypeTrustedLaunch, v1alpha1.SecurityTypeConfidentialVM},
+				},
+				{
+					Name:                  "Standard_NC6s_v3",
+					CPU:                   resource.NewQuantity(6, resource.DecimalSI),
+					Memory:                resource.NewQuantity(112*1024*1024*1024, resource.BinarySI),
+					GPU:                   resource.NewQuantity(1, resource.DecimalSI),
+					AcceleratedNetworking: pointer.Bool(false),
+					PremiumIO:             pointer.Bool(true),
+					UltraSSD:              pointer.Bool(true),
+					MaxNetworkInterfaces:  pointer.Int32(4),
+					CacheDiskSizeGB:       pointer.Int32(367),
+					ResourceDiskSizeGB:    pointer.Int32(336),
+					EphemeralOSDisk:       pointer.Bool(true),
+					MaxDataDisks:          pointer.Int32(24),
+					Architectures:         []string{"amd64"},
+					Zones:                 []v1alpha1.MachineTypeZones{{Region: "westeurope", Names: []string{"1", "3"}}},
+				},
+			}))
+		})
+	})
+})
@@ -13,6 +13,7 @@ const (
 	Name = "provider-azure"
 
 	// ShootVmoUsageAnnotation is an annotation assigned to the Shoot resource which indicates if VMO should be used.
+	// Deprecated: Use the orchestration mode of the InfrastructureConfig instead.
 	ShootVmoUsageAnnotation = "alpha.azure.provider.extensions.gardener.cloud/vmo"
 
 	// NetworkLayoutZoneMigrationAnnotation is used when migrating from a single subnet network layout to a multiple subnet network layout to indicate the zone that the existing subnet should be assigned to.
@@ -134,10 +135,19 @@ const (
 	// that hold service principal information to a corresponding AD tenant.
 	ExtensionPurposeServicePrincipalSecret = "tenant-service-principal-secret"
 
+	// ScaleSetPriorityLabel is the label and taint key for nodes running on spot capacity. It is the same key as used by
+	// AKS, so that workloads prepared for AKS spot node pools can be scheduled without changes.
+	ScaleSetPriorityLabel = "kubernetes.azure.com/scalesetpriority"
+	// ScaleSetPrioritySpot is the value of the ScaleSetPriorityLabel for spot nodes.
+	ScaleSetPrioritySpot = "spot"
+
 	// AnnotationKeyUseFlow is the annotation key used to enable reconciliation with flow.
 	AnnotationKeyUseFlow = "azure.provider.extensions.gardener.cloud/use-flow"
 	// AnnotationKeyUseTF is the annotation key used to enable reconciliation terraformer.
 	AnnotationKeyUseTF = "azure.provider.extensions.gardener.cloud/use-tf"
+	// AnnotationKeyMigrateLoadBalancers is the annotation key used to request the migration of the load balancers of a
+	// cluster, which was migrated to zones, to the standard SKU.
+	AnnotationKeyMigrateLoadBalancers = "azure.provider.extensions.gardener.cloud/migrate-load-balancers"
 	// SeedLabelKeyUseFlow is the label for seeds to enable flow reconciliation for all of its shoots if value is `true`
 	// or for new shoots only with value `new`
 	SeedLabelKeyUseFlow = AnnotationKeyUseFlow
@@ -145,5 +155,23 @@ const (
 	SeedLabelUseFlowValueNew = "new"
 )
 
+// Types of Azure managed disks.
+const (
+	// DiskTypeStandardLRS is the type of standard HDD disks.
+	DiskTypeStandardLRS = "Standard_LRS"
+	// DiskTypeStandardSSDLRS is the type of locally redundant standard SSD disks.
+	DiskTypeStandardSSDLRS = "StandardSSD_LRS"
+	// DiskTypeStandardSSDZRS is the type of zone redundant standard SSD disks.
+	DiskTypeStandardSSDZRS = "StandardSSD_ZRS"
+	// DiskTypePremiumLRS is the type of locally redundant premium SSD disks.
+	DiskTypePremiumLRS = "Premium_LRS"
+	// DiskTypePremiumZRS is the type of zone redundant premium SSD disks.
+	DiskTypePremiumZRS = "Premium_ZRS"
+	// DiskTypePremiumV2LRS is the type of premium SSD v2 disks.
+	DiskTypePremiumV2LRS = "PremiumV2_LRS"
+	// DiskTypeUltraSSDLRS is the type of ultra disks.
+	DiskTypeUltraSSDLRS = "UltraSSD_LRS"
+)
+
 // UsernamePrefix is a constant for the username prefix of components deployed by Azure.
 var UsernamePrefix = extensionsv1alpha1.SchemeGroupVersion.Group + ":" + Name + ":"
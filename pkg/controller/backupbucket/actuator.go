@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/backupbucket"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,23 +46,23 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, backupBucket *e
 	if backupBucket.Status.GeneratedSecretRef == nil {
 		storageAccountName, storageAccountKey, err := ensureBackupBucket(ctx, factory, backupBucket)
 		if err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
+			return helper.DetermineError(err)
 		}
 		// Create the generated backupbucket secret.
 		if err := a.createBackupBucketGeneratedSecret(ctx, backupBucket, storageAccountName, storageAccountKey); err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
+			return helper.DetermineError(err)
 		}
 	}
 
 	storageClient, err := DefaultBlobStorageClient(ctx, a.client, *backupBucket.Status.GeneratedSecretRef)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
-	return util.DetermineError(storageClient.CreateContainerIfNotExists(ctx, backupBucket.Name), helper.KnownCodes)
+	return helper.DetermineError(storageClient.CreateContainerIfNotExists(ctx, backupBucket.Name))
 }
 
 func (a *actuator) Delete(ctx context.Context, logger logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
-	return util.DetermineError(a.delete(ctx, logger, backupBucket), helper.KnownCodes)
+	return helper.DetermineError(a.delete(ctx, logger, backupBucket))
 }
 
 func (a *actuator) delete(ctx context.Context, _ logr.Logger, backupBucket *extensionsv1alpha1.BackupBucket) error {
@@ -12,7 +12,6 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/to"
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	ctrlerror "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/go-logr/logr"
@@ -39,12 +38,12 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 
 	err = removeBastionInstance(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove bastion instance: %w", err))
 	}
 
 	deleted, err := isInstanceDeleted(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to check for bastion instance: %w", err))
 	}
 
 	if !deleted {
@@ -56,22 +55,22 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 
 	err = removeNic(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove nic: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove nic: %w", err))
 	}
 
 	err = removePublicIP(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove public ip: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove public ip: %w", err))
 	}
 
 	err = removeDisk(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove disk: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove disk: %w", err))
 	}
 
 	err = removeNSGRule(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("failed to remove nsg rules: %w", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("failed to remove nsg rules: %w", err))
 	}
 
 	return nil
@@ -16,7 +16,6 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	ctrlerror "github.com/gardener/gardener/pkg/controllerutils/reconciler"
 	"github.com/gardener/gardener/pkg/extensions"
@@ -67,12 +66,12 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 
 	publicIP, err := ensurePublicIPAddress(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	nic, err := ensureNic(ctx, log, factory, infrastructureStatus, opt, publicIP)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	opt.NicID = *nic.ID
@@ -80,7 +79,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	// assume it's not possible to not have an ipv4 address
 	opt.PrivateIPAddressV4, err = getPrivateIPv4Address(nic)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	opt.PrivateIPAddressV6, err = getPrivateIPv6Address(nic)
@@ -90,18 +89,18 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 
 	err = ensureNetworkSecurityGroups(ctx, log, factory, opt)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	err = ensureComputeInstance(ctx, log, bastion, factory, opt)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// check if the instance already exists and has an IP
 	endpoints, err := getInstanceEndpoints(nic, publicIP)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	if !endpoints.Ready() {
@@ -482,6 +482,9 @@ func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazur
 		return values
 	}
 
+	// The cloud-provider-config keeps referring to the availability set until all machines have been moved to zones.
+	// The infrastructure status contains the availability set as long as machines are placed in it, which is the same
+	// check the infrastructure reconciliation uses to delete the availability set.
 	if primaryAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes); err == nil {
 		values["availabilitySetName"] = primaryAvailabilitySet.Name
 	}
@@ -489,6 +492,13 @@ func appendMachineSetValues(values map[string]interface{}, infraStatus *apisazur
 	return values
 }
 
+// usesPrimaryAvailabilitySet returns true if the machines of the cluster are placed in its primary availability set,
+// which requires Basic SKU load balancers. This is also the case while a non-zonal cluster is migrated to zones.
+func usesPrimaryAvailabilitySet(infraStatus *apisazure.InfrastructureStatus) bool {
+	_, err := azureapihelper.FindAvailabilitySetByPurpose(infraStatus.AvailabilitySets, apisazure.PurposeNodes)
+	return err == nil
+}
+
 // getInfraNames determines the subnet, availability set, route table and security group names from the given infrastructure status.
 func getInfraNames(infraStatus *apisazure.InfrastructureStatus) (string, string, string, error) {
 	_, nodesSubnet, err := azureapihelper.FindSubnetByPurposeAndZone(infraStatus.Networks.Subnets, apisazure.PurposeNodes, nil)
@@ -689,7 +699,7 @@ func getControlPlaneShootChartValues(
 		"global": map[string]interface{}{
 			"vpaEnabled": gardencorev1beta1helper.ShootWantsVerticalPodAutoscaler(cluster.Shoot),
 		},
-		azure.AllowEgressName: map[string]interface{}{"enabled": infraStatus.Zoned || azureapihelper.IsVmoRequired(infraStatus)},
+		azure.AllowEgressName: map[string]interface{}{"enabled": !usesPrimaryAvailabilitySet(infraStatus)},
 		azure.CloudControllerManagerName: map[string]interface{}{
 			"enabled":     true,
 			"pspDisabled": pspDisabled,
@@ -284,6 +284,33 @@ var _ = Describe("ValuesProvider", func() {
 				}))
 			})
 
+			It("should keep the availability set in the config chart values while machines of a cluster migrated to zones use it", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				infrastructureStatus.Zoned = true
+				infrastructureStatus.AvailabilitySets = []apisazure.AvailabilitySet{primaryAvailabilitySet}
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).To(HaveKeyWithValue("availabilitySetName", primaryAvailabilitySetName))
+				Expect(values).To(HaveKeyWithValue("vmType", "standard"))
+			})
+
+			It("should switch the config chart values once no machine of a cluster migrated to zones uses the availability set", func() {
+				c.EXPECT().Delete(ctx, azureContainerRegistryConfigMap).Return(errorAzureContainerRegistryConfigMapNotFound)
+
+				// the availability set is removed from the status once it is empty.
+				infrastructureStatus.Zoned = true
+				infrastructureStatus.AvailabilitySets = nil
+				cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+				values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values).NotTo(HaveKey("availabilitySetName"))
+				Expect(values).To(HaveKeyWithValue("vmType", "standard"))
+			})
+
 			It("should return correct control plane chart values with identity", func() {
 				identityName := "identity-client-id"
 				infrastructureStatus.Identity = &apisazure.IdentityStatus{
@@ -623,6 +650,16 @@ var _ = Describe("ValuesProvider", func() {
 			}))
 		})
 
+		It("should not allow egress via a standard load balancer while machines of a cluster migrated to zones use the availability set", func() {
+			infrastructureStatus.Zoned = true
+			infrastructureStatus.AvailabilitySets = []apisazure.AvailabilitySet{primaryAvailabilitySet}
+			cp := generateControlPlane(controlPlaneConfig, infrastructureStatus)
+
+			values, err := vp.GetControlPlaneShootChartValues(ctx, cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(azure.AllowEgressName, enabledFalse))
+		})
+
 		It("should return correct control plane shoot chart values for cluster with vmss flex (vmo, non zoned)", func() {
 			infrastructureStatus.Zoned = false
 			infrastructureStatus.AvailabilitySets = []apisazure.AvailabilitySet{}
@@ -11,7 +11,6 @@ import (
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/dnsrecord"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
@@ -55,17 +54,17 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, dns *extensio
 	}
 	dnsZoneClient, err := clientFactory.DNSZone()
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %+v", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %w", err))
 	}
 	dnsRecordSetClient, err := clientFactory.DNSRecordSet()
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %+v", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %w", err))
 	}
 
 	// Determine DNS zone ID
 	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// Create or update DNS recordset
@@ -106,17 +105,17 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, dns *extensionsv
 	// Create Azure DNS zone and recordset clients
 	dnsZoneClient, err := clientFactory.DNSZone()
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %+v", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("could not create Azure DNS zone client: %w", err))
 	}
 	dnsRecordSetClient, err := clientFactory.DNSRecordSet()
 	if err != nil {
-		return util.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %+v", err), helper.KnownCodes)
+		return helper.DetermineError(fmt.Errorf("could not create Azure DNS recordset client: %w", err))
 	}
 
 	// Determine DNS zone ID
 	zone, err := a.getZone(ctx, log, dns, dnsZoneClient)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
+		return helper.DetermineError(err)
 	}
 
 	// Delete DNS recordset
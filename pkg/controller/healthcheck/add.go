@@ -14,7 +14,6 @@ import (
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/worker"
 	extensionspredicate "github.com/gardener/gardener/extensions/pkg/predicate"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -99,13 +98,21 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 		mgr,
 		opts,
 		nil,
-		[]healthcheck.ConditionTypeToHealthCheck{{
-			ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
-			HealthCheck:   worker.NewNodesChecker(),
-			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
-				return util.DetermineErrorCodes(err, helper.KnownCodes)
+		[]healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
+				HealthCheck:   worker.NewNodesChecker(),
+				ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
+					return helper.DetermineErrorCodes(err)
+				},
 			},
-		}},
+			{
+				// Machines which are slow to provision turn the condition False once they reach the stuck machine
+				// threshold, see NewStuckMachinesChecker.
+				ConditionType: string(gardencorev1beta1.ShootEveryNodeReady),
+				HealthCheck:   NewStuckMachinesChecker(),
+			},
+		},
 		sets.New(gardencorev1beta1.ShootControlPlaneHealthy),
 	)
 }
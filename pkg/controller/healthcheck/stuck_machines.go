@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+// StuckMachinesChecker reports the machines with boot diagnostics which are stuck in creation. In contrast to the
+// stuck machines in the provider status of the Worker, which is only updated when the Worker is reconciled, the check
+// also reports machines while the reconciliation is waiting for them.
+type StuckMachinesChecker struct {
+	logger     logr.Logger
+	seedClient client.Client
+}
+
+// NewStuckMachinesChecker returns a health check which reports the machines with boot diagnostics which are stuck in
+// creation. It implements the healthcheck.HealthCheck interface.
+// The check contributes to the EveryNodeReady condition, hence the condition turns False while a machine is in creation
+// for longer than the StuckMachineThreshold, even if the machine eventually joins the cluster. It does not report an
+// error code, as slow provisioning is not necessarily caused by the user.
+func NewStuckMachinesChecker() *StuckMachinesChecker {
+	return &StuckMachinesChecker{}
+}
+
+// InjectSeedClient injects the seed client.
+func (h *StuckMachinesChecker) InjectSeedClient(seedClient client.Client) {
+	h.seedClient = seedClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (h *StuckMachinesChecker) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-stuck-machines", provider, extension))
+}
+
+// DeepCopy clones the healthCheck struct by making a copy and returning the pointer to that new copy.
+func (h *StuckMachinesChecker) DeepCopy() healthcheck.HealthCheck {
+	shallowCopy := *h
+	return &shallowCopy
+}
+
+// Check executes the health check.
+func (h *StuckMachinesChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	machines, err := internal.FindStuckMachines(ctx, h.seedClient, request.Namespace)
+	if err != nil {
+		err := fmt.Errorf("unable to check for stuck machines in namespace %q: %w", request.Namespace, err)
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	if len(machines) == 0 {
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	names := make([]string, 0, len(machines))
+	for _, machine := range machines {
+		names = append(names, machine.Name)
+	}
+	return &healthcheck.SingleCheckResult{
+		Status: gardencorev1beta1.ConditionFalse,
+		Detail: fmt.Sprintf("machines %s are still in creation after %s, their serial log can be retrieved via boot diagnostics", strings.Join(names, ", "), internal.StuckMachineThreshold),
+	}, nil
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
@@ -16,7 +15,7 @@ import (
 )
 
 func (a *actuator) Delete(ctx context.Context, log logr.Logger, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	return util.DetermineError(a.delete(ctx, log, SelectorFunc(OnDelete), infra, cluster), helper.KnownCodes)
+	return helper.DetermineError(a.delete(ctx, log, SelectorFunc(OnDelete), infra, cluster))
 }
 
 // Delete implements infrastructure.Actuator.
@@ -8,7 +8,6 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/extensions/pkg/controller"
-	"github.com/gardener/gardener/extensions/pkg/util"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 
@@ -24,5 +23,5 @@ func (a *actuator) Migrate(ctx context.Context, log logr.Logger, infra *extensio
 		return err
 	}
 	err = CleanupTerraformerResources(ctx, tf)
-	return util.DetermineError(err, helper.KnownCodes)
+	return helper.DetermineError(err)
 }
@@ -14,7 +14,6 @@ import (
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
 	infrainternal "github.com/gardener/gardener-extension-provider-azure/pkg/internal/infrastructure"
@@ -40,7 +39,7 @@ func NewFlowReconciler(a *actuator, log logr.Logger, projToken bool) (Reconciler
 
 // Reconcile reconciles the infrastructure and returns the status (state of the world), the state (input for the next loops) and any errors that occurred.
 func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, cluster *controller.Cluster) error {
-	infraState, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+	infraState, err := infraflow.LoadInfrastructureState(infra.Status.State)
 	if err != nil {
 		return err
 	}
@@ -53,7 +52,7 @@ func (f *FlowReconciler) Reconcile(ctx context.Context, infra *extensionsv1alpha
 	if !tf.IsStateEmpty(ctx) {
 		// this is a special case when migrating from Terraform. If TF had created any resources (meaning there is an actual tf.state written)
 		// we mark that there are infra resources created.
-		infraState.Data[infraflow.CreatedResourcesExistKey] = "true"
+		infraflow.StateResourcesExist.Set(infraState, true)
 	}
 
 	auth, err := internal.GetClientAuthData(ctx, f.client, infra.Spec.SecretRef, false)
@@ -94,12 +93,16 @@ func (f *FlowReconciler) Delete(ctx context.Context, infra *extensionsv1alpha1.I
 		return err
 	}
 
-	infraState, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+	infraState, err := infraflow.LoadInfrastructureState(infra.Status.State)
 	if err != nil {
 		return err
 	}
 
-	fctx, err := infraflow.NewFlowContext(factory, nil, f.log, infra, cluster, infraState, nil)
+	persistFunc := func(ctx context.Context, state *runtime.RawExtension) error {
+		return patchProviderStatusAndState(ctx, f.client, infra, nil, state)
+	}
+
+	fctx, err := infraflow.NewFlowContext(factory, nil, f.log, infra, cluster, infraState, persistFunc)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// EnsureApplicationSecurityGroups creates or updates the application security groups of the shoot.
+func (f *FlowContext) EnsureApplicationSecurityGroups(ctx context.Context) error {
+	log := f.LogFromContext(ctx)
+
+	cfgs := f.adapter.ApplicationSecurityGroupConfigs()
+	if len(cfgs) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.ApplicationSecurityGroup()
+	if err != nil {
+		return err
+	}
+
+	var joinErr error
+	for _, cfg := range cfgs {
+		asg, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name)
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+
+		asg = cfg.ToProvider(asg)
+		log.V(2).Info("reconciling application security group", "name", cfg.Name)
+		asg, err = c.CreateOrUpdate(ctx, cfg.ResourceGroup, cfg.Name, *asg)
+		if err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		if err := f.inventory.Insert(*asg.ID); err != nil {
+			joinErr = errors.Join(joinErr, err)
+		}
+	}
+
+	return joinErr
+}
+
+// DeleteStaleApplicationSecurityGroups deletes the application security groups which were removed from the
+// configuration. As long as machines are still assigned to a group, its deletion fails. The failure is not returned,
+// since the machines are only replaced once the infrastructure is reconciled, and the deletion is retried on the next
+// reconciliation instead.
+func (f *FlowContext) DeleteStaleApplicationSecurityGroups(ctx context.Context) error {
+	log := f.LogFromContext(ctx)
+
+	desired := map[string]struct{}{}
+	for _, cfg := range f.adapter.ApplicationSecurityGroupConfigs() {
+		desired[strings.ToLower(cfg.Name)] = struct{}{}
+	}
+
+	ids := f.inventory.IDsByKind(KindApplicationSecurityGroup)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.ApplicationSecurityGroup()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		resource := f.inventory.Get(id)
+		if _, ok := desired[strings.ToLower(resource.Name)]; ok {
+			continue
+		}
+
+		log.Info("deleting application security group because it is not needed", "name", resource.Name)
+		if err := c.Delete(ctx, resource.ResourceGroupName, resource.Name); err != nil {
+			log.Error(err, "failed to delete application security group, will retry on the next reconciliation", "name", resource.Name)
+			continue
+		}
+		f.inventory.Delete(id)
+	}
+
+	return nil
+}
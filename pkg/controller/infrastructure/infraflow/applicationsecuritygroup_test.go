@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ApplicationSecurityGroups", func() {
+	const (
+		name    = "shoot--foo--bar"
+		webID   = rgID + "/providers/Microsoft.Network/applicationSecurityGroups/" + name + "-asg-web"
+		staleID = rgID + "/providers/Microsoft.Network/applicationSecurityGroups/" + name + "-asg-db"
+		nsgName = name + "-workers"
+	)
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		asgs  *mockclient.MockApplicationSecurityGroup
+		nsgs  *mockclient.MockNetworkSecurityGroup
+		infra *extensionsv1alpha1.Infrastructure
+
+		factory *mockclient.MockFactory
+	)
+
+	newFlowContext := func(networks string, items ...string) *infraflow.FlowContext {
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": ` + networks + `
+}`)}
+
+		state := &azure.InfrastructureState{}
+		for _, id := range items {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+		fctx, err := infraflow.NewFlowContext(factory, &internal.ClientAuth{SubscriptionID: "sub"}, logr.Discard(), infra, &controller.Cluster{}, state, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		raw, err := fctx.GetInfrastructureState()
+		Expect(err).NotTo(HaveOccurred())
+		return raw.Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		asgs = mockclient.NewMockApplicationSecurityGroup(ctrl)
+		nsgs = mockclient.NewMockNetworkSecurityGroup(ctrl)
+		factory.EXPECT().ApplicationSecurityGroup().Return(asgs, nil).AnyTimes()
+		factory.EXPECT().NetworkSecurityGroup().Return(nsgs, nil).AnyTimes()
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureApplicationSecurityGroups", func() {
+		It("should create the application security groups and report them in the status", func() {
+			asgs.EXPECT().Get(gomock.Any(), name, name+"-asg-web").Return(nil, nil)
+			asgs.EXPECT().CreateOrUpdate(gomock.Any(), name, name+"-asg-web", armnetwork.ApplicationSecurityGroup{
+				Location: to.Ptr("westeurope"),
+				Name:     to.Ptr(name + "-asg-web"),
+			}).Return(&armnetwork.ApplicationSecurityGroup{ID: to.Ptr(webID)}, nil)
+
+			fctx := newFlowContext(`{"workers": "10.250.0.0/16", "applicationSecurityGroups": [{"name": "web"}]}`, rgID)
+			Expect(fctx.EnsureApplicationSecurityGroups(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ContainElement(v1alpha1.AzureResource{Kind: infraflow.KindApplicationSecurityGroup.String(), ID: webID}))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.ApplicationSecurityGroups).To(ConsistOf(v1alpha1.ApplicationSecurityGroup{Name: "web", ID: webID}))
+		})
+	})
+
+	Describe("#DeleteStaleApplicationSecurityGroups", func() {
+		It("should delete the application security groups which are no longer configured", func() {
+			asgs.EXPECT().Delete(gomock.Any(), name, name+"-asg-db")
+
+			fctx := newFlowContext(`{"workers": "10.250.0.0/16", "applicationSecurityGroups": [{"name": "web"}]}`, rgID, webID, staleID)
+			Expect(fctx.DeleteStaleApplicationSecurityGroups(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).NotTo(ContainElement(HaveField("ID", staleID)))
+			Expect(managedItems(fctx)).To(ContainElement(HaveField("ID", webID)))
+		})
+
+		It("should keep the application security group in the inventory if it is still in use", func() {
+			asgs.EXPECT().Delete(gomock.Any(), name, name+"-asg-db").Return(errors.New("InUseApplicationSecurityGroupCannotBeDeleted"))
+
+			fctx := newFlowContext(`{"workers": "10.250.0.0/16"}`, rgID, staleID)
+			Expect(fctx.DeleteStaleApplicationSecurityGroups(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ContainElement(HaveField("ID", staleID)))
+		})
+	})
+
+	Describe("#EnsureSecurityGroup", func() {
+		It("should replace the configured rules and keep the rules of the cloud-controller-manager", func() {
+			ccmRule := &armnetwork.SecurityRule{Name: to.Ptr("a1b2c3-TCP-443-Internet")}
+			nsgs.EXPECT().Get(gomock.Any(), name, nsgName).Return(&armnetwork.SecurityGroup{
+				Location: to.Ptr("westeurope"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{ccmRule, {Name: to.Ptr("gardener-removed")}},
+				},
+			}, nil)
+			nsgs.EXPECT().CreateOrUpdate(gomock.Any(), name, nsgName, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, nsg armnetwork.SecurityGroup) (*armnetwork.SecurityGroup, error) {
+					Expect(nsg.Properties.SecurityRules).To(HaveLen(2))
+					Expect(nsg.Properties.SecurityRules[0]).To(Equal(ccmRule))
+					Expect(nsg.Properties.SecurityRules[1]).To(Equal(&armnetwork.SecurityRule{
+						Name: to.Ptr("gardener-allow-https"),
+						Properties: &armnetwork.SecurityRulePropertiesFormat{
+							Priority:                             to.Ptr[int32](100),
+							Direction:                            to.Ptr(armnetwork.SecurityRuleDirectionInbound),
+							Access:                               to.Ptr(armnetwork.SecurityRuleAccessAllow),
+							Protocol:                             to.Ptr(armnetwork.SecurityRuleProtocolTCP),
+							SourceAddressPrefixes:                to.SliceOfPtrs("10.0.0.0/8", "192.168.0.0/16"),
+							SourcePortRange:                      to.Ptr("*"),
+							DestinationPortRanges:                to.SliceOfPtrs("443", "8000-8080"),
+							DestinationApplicationSecurityGroups: []*armnetwork.ApplicationSecurityGroup{{ID: to.Ptr(webID)}},
+						},
+					}))
+					nsg.ID = to.Ptr(rgID + "/providers/Microsoft.Network/networkSecurityGroups/" + nsgName)
+					return &nsg, nil
+				})
+
+			fctx := newFlowContext(`{
+"workers": "10.250.0.0/16",
+"applicationSecurityGroups": [{"name": "web"}],
+"securityRules": [{
+  "name": "allow-https",
+  "priority": 100,
+  "direction": "Inbound",
+  "access": "Allow",
+  "protocol": "Tcp",
+  "source": {"addressPrefixes": ["10.0.0.0/8", "192.168.0.0/16"]},
+  "destination": {"applicationSecurityGroups": [{"name": "web"}], "portRanges": ["443", "8000-8080"]}
+}]}`, rgID)
+			Expect(fctx.EnsureSecurityGroup(ctx)).To(Succeed())
+		})
+	})
+})
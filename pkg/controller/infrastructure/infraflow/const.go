@@ -13,8 +13,16 @@ const (
 	// we inject this marker into the state to block the deletion without having first a successful reconciliation.
 	CreatedResourcesExistKey = "resources_exist"
 
+	// securityRulePrefix is the prefix of the security rules which are configured for the shoot.
+	securityRulePrefix = "gardener-"
+
 	// KeyManagedIdentityClientId is a key for the MI's client ID.
 	KeyManagedIdentityClientId = "managed_identity_client_id"
 	// KeyManagedIdentityId is a key for the MI's identity ID.
 	KeyManagedIdentityId = "managed_identity_id"
+	// KeyManagedIdentityPrincipalId is a key for the MI's principal ID.
+	KeyManagedIdentityPrincipalId = "managed_identity_principal_id"
+	// KeyZoneMigrationAvailabilitySet is a key for the availability set which still contains machines while the cluster
+	// is migrated to zones.
+	KeyZoneMigrationAvailabilitySet = "zone_migration_availability_set"
 )
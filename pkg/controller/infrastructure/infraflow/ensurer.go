@@ -123,6 +123,9 @@ func (f *FlowContext) ensureManagedVirtualNetwork(ctx context.Context) (*armnetw
 	if vnet != nil {
 		if location := pointer.StringDeref(vnet.Location, ""); location != f.adapter.Region() {
 			log.Error(NewSpecMismatchError(vnetCfg.AzureResourceMetadata, "location", f.adapter.Region(), location, nil), "vnet can't be reconciled and has to be deleted")
+			if err := f.removeResourceLocksFor(ctx, *vnet.ID); err != nil {
+				return nil, err
+			}
 			err = c.Delete(ctx, vnetCfg.ResourceGroup, vnetCfg.Name)
 			if err != nil {
 				return nil, err
@@ -512,6 +515,10 @@ func (f *FlowContext) ensureNatGateways(ctx context.Context) error {
 	}
 
 	for natName, nat := range toDelete {
+		if err := f.removeResourceLocksFor(ctx, nat); err != nil {
+			joinError = errors.Join(joinError, err)
+			continue
+		}
 		err := f.provider.DeleteNatGateway(ctx, f.adapter.ResourceGroupName(), natName)
 		if err != nil {
 			joinError = errors.Join(joinError, err)
@@ -576,17 +583,24 @@ func (f *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 		}
 	}
 
-	zones := f.adapter.Zones()
-	for _, z := range zones {
-		actual := z.Subnet.ToProvider(mappedSubnets[z.Subnet.Name])
+	desiredSubnet := func(subnet SubnetConfig, natGateway *NatGatewayConfig) *armnetwork.Subnet {
+		actual := subnet.ToProvider(mappedSubnets[subnet.Name])
 		rtCfg := f.adapter.RouteTableConfig()
 		sgCfg := f.adapter.SecurityGroupConfig()
 		actual.Properties.RouteTable = &armnetwork.RouteTable{ID: to.Ptr(GetIdFromTemplate(TemplateRouteTable, f.auth.SubscriptionID, rtCfg.ResourceGroup, rtCfg.Name))}
 		actual.Properties.NetworkSecurityGroup = &armnetwork.SecurityGroup{ID: to.Ptr(GetIdFromTemplate(TemplateSecurityGroup, f.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))}
-		if z.NatGateway != nil {
-			actual.Properties.NatGateway = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplateNatGateway, f.auth.SubscriptionID, z.NatGateway.ResourceGroup, z.NatGateway.Name))}
+		if natGateway != nil {
+			actual.Properties.NatGateway = &armnetwork.SubResource{ID: to.Ptr(GetIdFromTemplate(TemplateNatGateway, f.auth.SubscriptionID, natGateway.ResourceGroup, natGateway.Name))}
 		}
-		toReconcile[z.Subnet.Name] = actual
+		return actual
+	}
+
+	zones := f.adapter.Zones()
+	for _, z := range zones {
+		toReconcile[z.Subnet.Name] = desiredSubnet(z.Subnet, z.NatGateway)
+	}
+	for _, s := range f.adapter.AdditionalSubnets() {
+		toReconcile[s.Subnet.Name] = desiredSubnet(s.Subnet, s.NatGateway)
 	}
 
 	for name, current := range mappedSubnets {
@@ -608,6 +622,10 @@ func (f *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 	}
 
 	for name, subnet := range toDelete {
+		if err := f.removeResourceLocksFor(ctx, *subnet.ID); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
 		err := c.Delete(ctx, vnetRgroup, vnetName, name)
 		if err != nil {
 			joinErr = errors.Join(joinErr, err)
@@ -637,6 +655,13 @@ func (f *FlowContext) ensureSubnets(ctx context.Context) (err error) {
 
 // EnsureManagedIdentity reconciles the managed identity specificed in the config.
 func (f *FlowContext) EnsureManagedIdentity(ctx context.Context) (err error) {
+	if cfg := f.adapter.ManagedIdentityConfig(); cfg != nil {
+		return f.ensureManagedIdentity(ctx, *cfg)
+	}
+	// the identity may have been managed by Gardener before.
+	if err := f.deleteManagedIdentity(ctx); err != nil {
+		return err
+	}
 	if f.cfg.Identity == nil {
 		return nil
 	}
@@ -703,6 +728,23 @@ func (f *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.Infr
 			Migrated: z.Migrated,
 		})
 	}
+	for _, s := range f.adapter.AdditionalSubnets() {
+		status.Networks.Subnets = append(status.Networks.Subnets, v1alpha1.Subnet{
+			Name:                 s.Subnet.Name,
+			Purpose:              v1alpha1.PurposeAdditionalNodes,
+			Zone:                 s.Subnet.zone,
+			AdditionalSubnetName: to.Ptr(s.Name),
+		})
+	}
+
+	asgCfgs := f.adapter.ApplicationSecurityGroupConfigs()
+	for _, asg := range f.cfg.Networks.ApplicationSecurityGroups {
+		cfg := asgCfgs[asg.Name]
+		status.ApplicationSecurityGroups = append(status.ApplicationSecurityGroups, v1alpha1.ApplicationSecurityGroup{
+			Name: asg.Name,
+			ID:   GetIdFromTemplate(TemplateApplicationSecurityGroup, f.auth.SubscriptionID, cfg.ResourceGroup, cfg.Name),
+		})
+	}
 
 	if cfg := f.adapter.AvailabilitySetConfig(); cfg != nil {
 		status.AvailabilitySets = []v1alpha1.AvailabilitySet{
@@ -714,6 +756,17 @@ func (f *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.Infr
 				CountUpdateDomains: cfg.CountUpdateDomains,
 			},
 		}
+	} else if cfg := f.adapter.ZoneMigrationAvailabilitySetConfig(); cfg != nil && f.whiteboard.HasObject(KeyZoneMigrationAvailabilitySet) {
+		avset := f.whiteboard.GetObject(KeyZoneMigrationAvailabilitySet).(*armcompute.AvailabilitySet)
+		status.AvailabilitySets = []v1alpha1.AvailabilitySet{
+			{
+				Purpose:            v1alpha1.PurposeNodes,
+				ID:                 *avset.ID,
+				Name:               cfg.Name,
+				CountFaultDomains:  cfg.CountFaultDomains,
+				CountUpdateDomains: cfg.CountUpdateDomains,
+			},
+		}
 	}
 
 	if identity := f.cfg.Identity; identity != nil {
@@ -731,6 +784,8 @@ func (f *FlowContext) GetInfrastructureStatus(_ context.Context) (*v1alpha1.Infr
 func (f *FlowContext) GetInfrastructureState() (*runtime.RawExtension, error) {
 	state := &v1alpha1.InfrastructureState{
 		TypeMeta:     helper.InfrastructureStateTypeMeta,
+		Version:      CurrentStateVersion,
+		Data:         exportStateData(f.whiteboard),
 		ManagedItems: f.inventory.ToList(),
 	}
 
@@ -6,6 +6,8 @@ package infraflow
 
 import (
 	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // SpecMismatchError is an error to indicate that the reconciliation cannot proceed or the operation requested is not supported.
@@ -36,6 +38,11 @@ func (t *SpecMismatchError) Error() string {
 	return s
 }
 
+// Codes returns the error codes of the SpecMismatchError. Retrying does not help without user intervention.
+func (t *SpecMismatchError) Codes() []gardencorev1beta1.ErrorCode {
+	return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}
+}
+
 // TerminalConditionError is an error to mark cases where the reconciliation cannot continue.
 type TerminalConditionError struct {
 	AzureResourceMetadata
@@ -54,3 +61,8 @@ func (t *TerminalConditionError) Error() string {
 func (t *TerminalConditionError) Unwrap() error {
 	return t.error
 }
+
+// Codes returns the error codes of the TerminalConditionError. The condition persists until the configuration is changed.
+func (t *TerminalConditionError) Codes() []gardencorev1beta1.ErrorCode {
+	return []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem}
+}
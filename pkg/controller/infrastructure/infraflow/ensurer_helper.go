@@ -123,6 +123,17 @@ func (i *Inventory) ByKind(kind AzureResourceKind) []string {
 	return res
 }
 
+// IDsByKind returns a list of the IDs of all stored objects of a particular kind.
+func (i *Inventory) IDsByKind(kind AzureResourceKind) []string {
+	res := make([]string, 0)
+	for _, key := range i.GetChild(ChildKeyInventory).ObjectKeys() {
+		if resource := i.Get(key); resource != nil && resource.ResourceType.String() == kind.String() {
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
 // ToList returns a list of v1alpha1 API objects that correspond to the current inventory list.
 func (i *Inventory) ToList() []v1alpha1.AzureResource {
 	var res []v1alpha1.AzureResource
@@ -80,11 +80,18 @@ func NewFlowContext(factory client.Factory,
 		}
 	}
 
+	// the credentials are not required for the deletion of the infrastructure.
+	var subscriptionID string
+	if auth != nil {
+		subscriptionID = auth.SubscriptionID
+	}
+
 	adapter, err := NewInfrastructureAdapter(
 		infra,
 		cfg,
 		profile,
 		cluster,
+		subscriptionID,
 	)
 	if err != nil {
 		return nil, err
@@ -116,6 +123,16 @@ func NewFlowContext(factory client.Factory,
 
 // Reconcile reconciles target infrastructure.
 func (f *FlowContext) Reconcile(ctx context.Context) (*v1alpha1.InfrastructureStatus, *runtime.RawExtension, error) {
+	stateLost, err := f.IsStateLost()
+	if err != nil {
+		return nil, nil, err
+	}
+	if stateLost {
+		if err := f.RediscoverInventory(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	graph := f.buildReconcileGraph()
 	fl := graph.Compile()
 	if err := fl.Run(ctx, flow.Opts{
@@ -149,40 +166,87 @@ func (f *FlowContext) buildReconcileGraph() *flow.Graph {
 		f.EnsureAvailabilitySet, shared.DoIf(f.adapter.AvailabilitySetConfig() != nil),
 		shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
 
+	_ = f.AddTask(g, "delete availability set once empty",
+		f.DeleteAvailabilitySetIfEmpty, shared.DoIf(f.adapter.ZoneMigrationAvailabilitySetConfig() != nil),
+		shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+
+	_ = f.AddTask(g, "migrate load balancers to standard SKU",
+		f.MigrateLoadBalancersToStandardSKU, shared.DoIf(f.adapter.LoadBalancerMigrationRequested()),
+		shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup))
+
 	_ = f.AddTask(g, "ensure managed identity",
-		f.EnsureManagedIdentity, shared.DoIf(f.cfg.Identity != nil))
+		f.EnsureManagedIdentity, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
 
 	routeTable := f.AddTask(g, "ensure route table",
 		f.EnsureRouteTable, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
 
+	applicationSecurityGroups := f.AddTask(g, "ensure application security groups",
+		f.EnsureApplicationSecurityGroups, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+
 	securityGroup := f.AddTask(g, "ensure security group",
-		f.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup))
+		f.EnsureSecurityGroup, shared.Timeout(defaultTimeout), shared.Dependencies(resourceGroup, applicationSecurityGroups))
+
+	_ = f.AddTask(g, "delete stale application security groups",
+		f.DeleteStaleApplicationSecurityGroups, shared.Timeout(defaultTimeout), shared.Dependencies(securityGroup))
 
 	ip := f.AddTask(g, "ensure public IPs",
 		f.EnsurePublicIps, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup))
 	nat := f.AddTask(g, "ensure nats",
 		f.EnsureNatGateways, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceGroup, ip))
 
-	_ = f.AddTask(g, "ensure subnets", f.EnsureSubnets,
+	subnets := f.AddTask(g, "ensure subnets", f.EnsureSubnets,
 		shared.Timeout(defaultLongTimeout), shared.Dependencies(vnet, routeTable, securityGroup, nat))
+
+	_ = f.AddTask(g, "ensure resource locks", f.EnsureResourceLocks,
+		shared.Timeout(defaultTimeout), shared.Dependencies(vnet, subnets, nat))
 	return g
 }
 
+// IsStateLost returns true if the inventory is empty although resources were created before, i.e. the infrastructure
+// already has a status or the state is marked to have created resources, e.g. by Terraform. The first reconciliation of
+// a new infrastructure does not need to look for existing resources.
+func (f *FlowContext) IsStateLost() (bool, error) {
+	if len(f.state.ManagedItems) > 0 {
+		return false, nil
+	}
+	if f.infra.Status.ProviderStatus != nil {
+		return true, nil
+	}
+	resourcesExist, _, err := StateResourcesExist.Get(f.state)
+	return resourcesExist, err
+}
+
 // Delete deletes all resources managed by the reconciler
 func (f *FlowContext) Delete(ctx context.Context) error {
 	if len(f.state.ManagedItems) == 0 {
-		// special case where the credentials were invalid from the beginning
-		if _, ok := f.state.Data[CreatedResourcesExistKey]; !ok {
+		_, resourcesExist, err := StateResourcesExist.Get(f.state)
+		if err != nil {
+			return err
+		}
+
+		// the state may have been lost. Try to find any leftovers before giving up on the deletion.
+		if err := f.RediscoverInventory(ctx); err != nil {
+			// special case where the credentials were invalid from the beginning
+			if !resourcesExist && isAuthError(err) {
+				return nil
+			}
+			return err
+		}
+		if len(f.inventory.ToList()) == 0 && !resourcesExist {
 			return nil
 		}
 	}
 
 	g := flow.NewGraph("Azure infrastructure deletion")
 
+	resourceLocks := f.AddTask(g, "delete resource locks",
+		f.DeleteResourceLocks, shared.Timeout(defaultTimeout))
 	foreignSubnets := f.AddTask(g, "delete subnets in foreign resource group",
-		f.DeleteSubnetsInForeignGroup, shared.Timeout(defaultLongTimeout))
+		f.DeleteSubnetsInForeignGroup, shared.Timeout(defaultLongTimeout), shared.Dependencies(resourceLocks))
+	roleAssignments := f.AddTask(g, "delete role assignments",
+		f.DeleteRoleAssignments, shared.Timeout(defaultTimeout))
 	f.AddTask(g, "delete resource group",
-		f.DeleteResourceGroup, shared.Dependencies(foreignSubnets), shared.Timeout(defaultLongTimeout))
+		f.DeleteResourceGroup, shared.Dependencies(foreignSubnets, roleAssignments), shared.Timeout(defaultLongTimeout))
 
 	fl := g.Compile()
 	if err := fl.Run(ctx, flow.Opts{}); err != nil {
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/google/uuid"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// ensureManagedIdentity creates or updates the identity of the shoot and reconciles its role assignments.
+func (f *FlowContext) ensureManagedIdentity(ctx context.Context, cfg ManagedIdentityConfig) error {
+	log := f.LogFromContext(ctx)
+
+	c, err := f.factory.ManagedUserIdentity()
+	if err != nil {
+		return err
+	}
+
+	log.V(2).Info("reconciling managed identity", "name", cfg.Name)
+	identity, err := c.CreateOrUpdate(ctx, cfg.ResourceGroup, cfg.Name, msi.Identity{Location: to.Ptr(cfg.Location)})
+	if err != nil {
+		return err
+	}
+	if err := f.inventory.Insert(*identity.ID); err != nil {
+		return err
+	}
+	if identity.UserAssignedIdentityProperties == nil || identity.ClientID == nil || identity.PrincipalID == nil {
+		return fmt.Errorf("managed identity %s has no client or principal ID yet", *identity.ID)
+	}
+
+	f.whiteboard.Set(KeyManagedIdentityClientId, identity.ClientID.String())
+	f.whiteboard.Set(KeyManagedIdentityPrincipalId, identity.PrincipalID.String())
+	f.whiteboard.Set(KeyManagedIdentityId, *identity.ID)
+
+	return f.ensureRoleAssignments(ctx, *identity.ID, identity.PrincipalID.String(), cfg.RoleAssignments)
+}
+
+// deleteManagedIdentity deletes the identity of the shoot and its role assignments if they are tracked in the inventory.
+func (f *FlowContext) deleteManagedIdentity(ctx context.Context) error {
+	if err := f.DeleteRoleAssignments(ctx); err != nil {
+		return err
+	}
+
+	ids := f.inventory.IDsByKind(KindManagedIdentity)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.ManagedUserIdentity()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		resource := f.inventory.Get(id)
+		f.LogFromContext(ctx).Info("deleting managed identity because it is not needed", "name", resource.Name)
+		if err := c.Delete(ctx, resource.ResourceGroupName, resource.Name); err != nil {
+			return err
+		}
+		f.inventory.Delete(id)
+	}
+	return nil
+}
+
+// ensureRoleAssignments assigns the desired roles to the principal and removes the tracked assignments which are no
+// longer desired. Role assignments cannot be updated, therefore their names are derived from their content.
+func (f *FlowContext) ensureRoleAssignments(ctx context.Context, identityID, principalID string, assignments []azure.IdentityRoleAssignment) error {
+	var (
+		log     = f.LogFromContext(ctx)
+		desired = map[string]azure.IdentityRoleAssignment{}
+		joinErr error
+	)
+	for _, ra := range assignments {
+		ra.RoleDefinitionID = f.roleDefinitionID(ra.RoleDefinitionID)
+		desired[strings.ToLower(roleAssignmentID(identityID, ra))] = ra
+	}
+
+	existing := f.inventory.IDsByKind(KindRoleAssignment)
+	if len(desired) == 0 && len(existing) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.RoleAssignment()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range existing {
+		if _, ok := desired[strings.ToLower(id)]; ok {
+			continue
+		}
+		log.Info("deleting role assignment because it is not needed", "id", id)
+		if err := c.DeleteByID(ctx, id); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		f.inventory.Delete(id)
+	}
+
+	for _, ra := range desired {
+		id := roleAssignmentID(identityID, ra)
+		log.V(2).Info("reconciling role assignment", "scope", ra.Scope, "role", ra.RoleDefinitionID)
+		if _, err := c.Create(ctx, ra.Scope, roleAssignmentName(identityID, ra), authorization.RoleAssignmentCreateParameters{
+			RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+				RoleDefinitionID: to.Ptr(ra.RoleDefinitionID),
+				PrincipalID:      to.Ptr(principalID),
+				// skips the lookup of the principal, which may not have been replicated yet for new identities.
+				PrincipalType: authorization.ServicePrincipal,
+			},
+		}); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		if err := f.inventory.Insert(id); err != nil {
+			joinErr = errors.Join(joinErr, err)
+		}
+	}
+	return joinErr
+}
+
+// DeleteRoleAssignments deletes all role assignments tracked in the inventory. Unlike the identity itself, they are not
+// deleted together with the resource group if their scope is outside of it.
+func (f *FlowContext) DeleteRoleAssignments(ctx context.Context) error {
+	ids := f.inventory.IDsByKind(KindRoleAssignment)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.RoleAssignment()
+	if err != nil {
+		return err
+	}
+
+	var joinErr error
+	for _, id := range ids {
+		if err := c.DeleteByID(ctx, id); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		f.inventory.Delete(id)
+	}
+	return joinErr
+}
+
+// roleDefinitionID returns the fully qualified ID of a role definition, which may be given by its GUID only.
+func (f *FlowContext) roleDefinitionID(id string) string {
+	if strings.Contains(id, "/") {
+		return id
+	}
+	return fmt.Sprintf(TemplateRoleDefinition, f.auth.SubscriptionID, id)
+}
+
+func roleAssignmentName(identityID string, ra azure.IdentityRoleAssignment) string {
+	key := strings.ToLower(strings.Join([]string{identityID, ra.Scope, ra.RoleDefinitionID}, "|"))
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(key)).String()
+}
+
+func roleAssignmentID(identityID string, ra azure.IdentityRoleAssignment) string {
+	return fmt.Sprintf(TemplateRoleAssignment, ra.Scope, roleAssignmentName(identityID, ra))
+}
+
+// rediscoverRoleAssignments adds the role assignments of the rediscovered identity to the inventory.
+func (f *FlowContext) rediscoverRoleAssignments(ctx context.Context) error {
+	ids := f.inventory.IDsByKind(KindManagedIdentity)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	identities, err := f.factory.ManagedUserIdentity()
+	if err != nil {
+		return err
+	}
+	assignments, err := f.factory.RoleAssignment()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		resource := f.inventory.Get(id)
+		identity, err := identities.Get(ctx, resource.ResourceGroupName, resource.Name)
+		if err != nil {
+			return err
+		}
+		if identity == nil || identity.UserAssignedIdentityProperties == nil || identity.PrincipalID == nil {
+			continue
+		}
+
+		existing, err := assignments.ListForPrincipal(ctx, identity.PrincipalID.String())
+		if err != nil {
+			return err
+		}
+		for _, ra := range existing {
+			if ra.ID == nil {
+				continue
+			}
+			if err := f.inventory.Insert(*ra.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/services/msi/mgmt/2018-11-30/msi"
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/gofrs/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ManagedIdentity", func() {
+	const (
+		name       = "shoot--foo--bar"
+		identityID = rgID + "/providers/Microsoft.ManagedIdentity/userAssignedIdentities/" + name + "-identity"
+		acrScope   = "/subscriptions/sub/resourceGroups/acr/providers/Microsoft.ContainerRegistry/registries/acr"
+		acrPull    = "7f951dda-4ed3-4680-a7ca-43fe172d538d"
+		staleID    = "/subscriptions/sub/resourceGroups/vault/providers/Microsoft.Authorization/roleAssignments/foo"
+	)
+
+	var (
+		ctx         context.Context
+		ctrl        *gomock.Controller
+		factory     *mockclient.MockFactory
+		identities  *mockclient.MockManagedUserIdentity
+		assignments *mockclient.MockRoleAssignment
+		infra       *extensionsv1alpha1.Infrastructure
+
+		clientID    = uuid.Must(uuid.NewV4())
+		principalID = uuid.Must(uuid.NewV4())
+	)
+
+	newFlowContext := func(identity string, items ...string) *infraflow.FlowContext {
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": {"workers": "10.250.0.0/16"},
+"identity": ` + identity + `
+}`)}
+
+		state := &azure.InfrastructureState{}
+		for _, id := range items {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+		fctx, err := infraflow.NewFlowContext(factory, &internal.ClientAuth{SubscriptionID: "sub"}, logr.Discard(), infra, &controller.Cluster{}, state, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		raw, err := fctx.GetInfrastructureState()
+		Expect(err).NotTo(HaveOccurred())
+		return raw.Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		identities = mockclient.NewMockManagedUserIdentity(ctrl)
+		assignments = mockclient.NewMockRoleAssignment(ctrl)
+		factory.EXPECT().ManagedUserIdentity().Return(identities, nil).AnyTimes()
+		factory.EXPECT().RoleAssignment().Return(assignments, nil).AnyTimes()
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureManagedIdentity", func() {
+		It("should create the identity, assign the roles and remove stale assignments", func() {
+			identities.EXPECT().CreateOrUpdate(gomock.Any(), name, name+"-identity", gomock.Any()).Return(&msi.Identity{
+				ID: to.Ptr(identityID),
+				UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{
+					ClientID:    &clientID,
+					PrincipalID: &principalID,
+				},
+			}, nil)
+			assignments.EXPECT().DeleteByID(gomock.Any(), staleID)
+			assignments.EXPECT().Create(gomock.Any(), acrScope, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ string, params authorization.RoleAssignmentCreateParameters) (*authorization.RoleAssignment, error) {
+					Expect(*params.PrincipalID).To(Equal(principalID.String()))
+					Expect(*params.RoleDefinitionID).To(Equal("/subscriptions/sub/providers/Microsoft.Authorization/roleDefinitions/" + acrPull))
+					Expect(params.PrincipalType).To(Equal(authorization.ServicePrincipal))
+					return &authorization.RoleAssignment{}, nil
+				})
+
+			fctx := newFlowContext(`{"managed": true, "acrAccess": true, "roleAssignments": [{"roleDefinitionID": "`+acrPull+`", "scope": "`+acrScope+`"}]}`, rgID, staleID)
+			Expect(fctx.EnsureManagedIdentity(ctx)).To(Succeed())
+
+			items := managedItems(fctx)
+			Expect(items).To(ContainElement(v1alpha1.AzureResource{Kind: infraflow.KindManagedIdentity.String(), ID: identityID}))
+			Expect(items).To(ContainElement(HaveField("Kind", infraflow.KindRoleAssignment.String())))
+			Expect(items).NotTo(ContainElement(HaveField("ID", staleID)))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Identity).To(Equal(&v1alpha1.IdentityStatus{ID: identityID, ClientID: clientID.String(), ACRAccess: true}))
+		})
+
+		It("should delete the identity and its role assignments once it is no longer managed", func() {
+			gomock.InOrder(
+				assignments.EXPECT().DeleteByID(gomock.Any(), staleID),
+				identities.EXPECT().Delete(gomock.Any(), name, name+"-identity"),
+				identities.EXPECT().Get(gomock.Any(), "rg", "foo").Return(&msi.Identity{
+					ID:                             to.Ptr("/subscriptions/sub/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/foo"),
+					UserAssignedIdentityProperties: &msi.UserAssignedIdentityProperties{ClientID: &clientID},
+				}, nil),
+			)
+
+			fctx := newFlowContext(`{"name": "foo", "resourceGroup": "rg"}`, rgID, identityID, staleID)
+			Expect(fctx.EnsureManagedIdentity(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(v1alpha1.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rgID}))
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should delete the role assignments before the resource group", func() {
+			groups := mockclient.NewMockResourceGroup(ctrl)
+			factory.EXPECT().Group().Return(groups, nil)
+			gomock.InOrder(
+				assignments.EXPECT().DeleteByID(gomock.Any(), staleID),
+				groups.EXPECT().Delete(gomock.Any(), name),
+			)
+
+			fctx := newFlowContext(`{"managed": true}`, rgID, identityID, staleID)
+			Expect(fctx.Delete(ctx)).To(Succeed())
+		})
+	})
+})
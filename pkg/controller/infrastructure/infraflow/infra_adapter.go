@@ -13,6 +13,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
@@ -31,9 +32,10 @@ type InfrastructureAdapter struct {
 	subscriptionID string
 
 	// cached configuration
-	vnetConfig  VirtualNetworkConfig
-	avSetConfig *AvailabilitySetConfig
-	zoneConfigs []ZoneConfig
+	vnetConfig               VirtualNetworkConfig
+	avSetConfig              *AvailabilitySetConfig
+	zoneMigrationAvSetConfig *AvailabilitySetConfig
+	zoneConfigs              []ZoneConfig
 }
 
 // NewInfrastructureAdapter returns a new instance of the InfrastructureAdapter.
@@ -42,12 +44,14 @@ func NewInfrastructureAdapter(
 	config *azure.InfrastructureConfig,
 	profile *azure.CloudProfileConfig,
 	cluster *extensionscontroller.Cluster,
+	subscriptionID string,
 ) (*InfrastructureAdapter, error) {
 	ia := &InfrastructureAdapter{
-		infra:   infra,
-		config:  config,
-		profile: profile,
-		cluster: cluster,
+		infra:          infra,
+		config:         config,
+		profile:        profile,
+		cluster:        cluster,
+		subscriptionID: subscriptionID,
 	}
 	ia.vnetConfig = ia.virtualNetworkConfig()
 	avset, err := ia.availabilitySetConfig()
@@ -55,6 +59,10 @@ func NewInfrastructureAdapter(
 		return nil, err
 	}
 	ia.avSetConfig = avset
+	ia.zoneMigrationAvSetConfig, err = ia.zoneMigrationAvailabilitySetConfig()
+	if err != nil {
+		return nil, err
+	}
 
 	ia.zoneConfigs = ia.zonesConfig()
 	return ia, nil
@@ -175,7 +183,7 @@ func (ia *InfrastructureAdapter) availabilitySetConfig() (*AvailabilitySetConfig
 	asc := &AvailabilitySetConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
 			ResourceGroup: ia.ResourceGroupName(),
-			Name:          fmt.Sprintf("%s-avset-workers", ia.TechnicalName()),
+			Name:          ia.availabilitySetName(),
 			Kind:          KindAvailabilitySet,
 		},
 	}
@@ -224,6 +232,50 @@ func (ia *InfrastructureAdapter) availabilitySetConfig() (*AvailabilitySetConfig
 	return asc, nil
 }
 
+// ZoneMigrationAvailabilitySetConfig returns the configuration of the availability set of a non-zonal cluster which is
+// migrated to a zonal cluster. It is nil if no such migration is in progress.
+func (ia *InfrastructureAdapter) ZoneMigrationAvailabilitySetConfig() *AvailabilitySetConfig {
+	return ia.zoneMigrationAvSetConfig
+}
+
+// LoadBalancerMigrationRequested returns true if the load balancers of a cluster, which was migrated to zones, should
+// be migrated to the standard SKU. This is only the case if it was requested with an annotation and the availability set
+// was already removed from the status during a previous reconciliation, as the cloud-provider-config is only switched to
+// the standard SKU by the control plane reconciliation which follows it.
+func (ia *InfrastructureAdapter) LoadBalancerMigrationRequested() bool {
+	requested := helper.HasShootLoadBalancerMigrationAnnotation(ia.infra.Annotations) ||
+		(ia.cluster != nil && ia.cluster.Shoot != nil && helper.HasShootLoadBalancerMigrationAnnotation(ia.cluster.Shoot.Annotations))
+	return requested && ia.config.Zoned && ia.zoneMigrationAvSetConfig == nil
+}
+
+func (ia *InfrastructureAdapter) zoneMigrationAvailabilitySetConfig() (*AvailabilitySetConfig, error) {
+	if !ia.config.Zoned || ia.infra.Status.ProviderStatus == nil {
+		return nil, nil
+	}
+
+	status, err := helper.InfrastructureStatusFromRaw(ia.infra.Status.ProviderStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	nodesAvSet, err := helper.FindAvailabilitySetByPurpose(status.AvailabilitySets, azure.PurposeNodes)
+	if err != nil {
+		// the cluster was either zonal from the beginning or its availability set is already gone.
+		return nil, nil
+	}
+
+	return &AvailabilitySetConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          nodesAvSet.Name,
+			Kind:          KindAvailabilitySet,
+		},
+		CountFaultDomains:  nodesAvSet.CountFaultDomains,
+		CountUpdateDomains: nodesAvSet.CountUpdateDomains,
+		Location:           ia.Region(),
+	}, nil
+}
+
 // RouteTableConfig is the desired configuration for a route table.
 type RouteTableConfig struct {
 	AzureResourceMetadata
@@ -235,7 +287,7 @@ func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
 	return RouteTableConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
 			ResourceGroup: ia.ResourceGroupName(),
-			Name:          "worker_route_table",
+			Name:          ia.routeTableName(),
 			Kind:          KindRouteTable,
 		},
 		Location: ia.Region(),
@@ -246,18 +298,101 @@ func (ia *InfrastructureAdapter) RouteTableConfig() RouteTableConfig {
 type SecurityGroupConfig struct {
 	AzureResourceMetadata
 	Location string
+	// Rules are the security rules configured for the shoot. The security group contains further rules which are
+	// managed by the cloud-controller-manager.
+	Rules []*armnetwork.SecurityRule
 }
 
 // SecurityGroupConfig returns the configuration for our desired security group.
 func (ia *InfrastructureAdapter) SecurityGroupConfig() SecurityGroupConfig {
-	return SecurityGroupConfig{
+	cfg := SecurityGroupConfig{
 		AzureResourceMetadata: AzureResourceMetadata{
 			ResourceGroup: ia.ResourceGroupName(),
-			Name:          fmt.Sprintf("%s-workers", ia.TechnicalName()),
+			Name:          ia.securityGroupName(),
 			Kind:          KindSecurityGroup,
 		},
 		Location: ia.Region(),
 	}
+	for _, rule := range ia.config.Networks.SecurityRules {
+		cfg.Rules = append(cfg.Rules, ia.securityRule(rule))
+	}
+	return cfg
+}
+
+func (ia *InfrastructureAdapter) securityRule(rule azure.SecurityRule) *armnetwork.SecurityRule {
+	properties := &armnetwork.SecurityRulePropertiesFormat{
+		Priority:  to.Ptr(rule.Priority),
+		Direction: to.Ptr(armnetwork.SecurityRuleDirection(rule.Direction)),
+		Access:    to.Ptr(armnetwork.SecurityRuleAccess(rule.Access)),
+		Protocol:  to.Ptr(armnetwork.SecurityRuleProtocol(rule.Protocol)),
+	}
+
+	properties.SourceAddressPrefix, properties.SourceAddressPrefixes = singleOrList(rule.Source.AddressPrefixes, len(rule.Source.ApplicationSecurityGroups) == 0)
+	properties.SourcePortRange, properties.SourcePortRanges = singleOrList(rule.Source.PortRanges, true)
+	properties.SourceApplicationSecurityGroups = ia.applicationSecurityGroups(rule.Source.ApplicationSecurityGroups)
+	properties.DestinationAddressPrefix, properties.DestinationAddressPrefixes = singleOrList(rule.Destination.AddressPrefixes, len(rule.Destination.ApplicationSecurityGroups) == 0)
+	properties.DestinationPortRange, properties.DestinationPortRanges = singleOrList(rule.Destination.PortRanges, true)
+	properties.DestinationApplicationSecurityGroups = ia.applicationSecurityGroups(rule.Destination.ApplicationSecurityGroups)
+
+	return &armnetwork.SecurityRule{
+		Name:       to.Ptr(securityRuleName(rule.Name)),
+		Properties: properties,
+	}
+}
+
+func (ia *InfrastructureAdapter) applicationSecurityGroups(refs []azure.ApplicationSecurityGroupReference) []*armnetwork.ApplicationSecurityGroup {
+	var res []*armnetwork.ApplicationSecurityGroup
+	for _, ref := range refs {
+		res = append(res, &armnetwork.ApplicationSecurityGroup{ID: to.Ptr(ia.ApplicationSecurityGroupID(ref))})
+	}
+	return res
+}
+
+// ApplicationSecurityGroupID returns the id of the referenced application security group.
+func (ia *InfrastructureAdapter) ApplicationSecurityGroupID(ref azure.ApplicationSecurityGroupReference) string {
+	if ref.ID != nil {
+		return *ref.ID
+	}
+	return GetIdFromTemplate(TemplateApplicationSecurityGroup, ia.subscriptionID, ia.ResourceGroupName(), ia.applicationSecurityGroupName(*ref.Name))
+}
+
+// singleOrList returns the single value or the list of values, as Azure expects either of them to be set for the
+// prefixes and port ranges of a security rule. If no values are given, the wildcard is returned if allowed.
+func singleOrList(values []string, wildcard bool) (*string, []*string) {
+	switch len(values) {
+	case 0:
+		if wildcard {
+			return to.Ptr("*"), nil
+		}
+		return nil, nil
+	case 1:
+		return to.Ptr(values[0]), nil
+	default:
+		return nil, to.SliceOfPtrs(values...)
+	}
+}
+
+// ApplicationSecurityGroupConfig is the desired configuration for an application security group.
+type ApplicationSecurityGroupConfig struct {
+	AzureResourceMetadata
+	Location string
+}
+
+// ApplicationSecurityGroupConfigs returns the configuration of the application security groups created for the shoot
+// keyed by their name within the shoot.
+func (ia *InfrastructureAdapter) ApplicationSecurityGroupConfigs() map[string]ApplicationSecurityGroupConfig {
+	res := map[string]ApplicationSecurityGroupConfig{}
+	for _, asg := range ia.config.Networks.ApplicationSecurityGroups {
+		res[asg.Name] = ApplicationSecurityGroupConfig{
+			AzureResourceMetadata: AzureResourceMetadata{
+				ResourceGroup: ia.ResourceGroupName(),
+				Name:          ia.applicationSecurityGroupName(asg.Name),
+				Kind:          KindApplicationSecurityGroup,
+			},
+			Location: ia.Region(),
+		}
+	}
+	return res
 }
 
 // PublicIPConfig contains configuration for a public IP resource.
@@ -292,6 +427,63 @@ type ZoneConfig struct {
 	Migrated   bool
 }
 
+// AdditionalSubnetConfig is the specification for an additional subnet of the worker nodes.
+type AdditionalSubnetConfig struct {
+	// Name is the name of the subnet in the infrastructure configuration.
+	Name       string
+	Subnet     SubnetConfig
+	NatGateway *NatGatewayConfig
+}
+
+// ManagedIdentityConfig is the desired configuration for the user-assigned identity created for the shoot.
+type ManagedIdentityConfig struct {
+	AzureResourceMetadata
+	Location        string
+	RoleAssignments []azure.IdentityRoleAssignment
+}
+
+// ManagedIdentityConfig returns the configuration for the shoot's identity, or nil if the identity is not managed by Gardener.
+func (ia *InfrastructureAdapter) ManagedIdentityConfig() *ManagedIdentityConfig {
+	if ia.config.Identity == nil || !ia.config.Identity.Managed {
+		return nil
+	}
+	return &ManagedIdentityConfig{
+		AzureResourceMetadata: AzureResourceMetadata{
+			ResourceGroup: ia.ResourceGroupName(),
+			Name:          ia.managedIdentityName(),
+			Kind:          KindManagedIdentity,
+		},
+		Location:        ia.Region(),
+		RoleAssignments: ia.config.Identity.RoleAssignments,
+	}
+}
+
+func (ia *InfrastructureAdapter) availabilitySetName() string {
+	return fmt.Sprintf("%s-avset-workers", ia.TechnicalName())
+}
+
+func (ia *InfrastructureAdapter) routeTableName() string {
+	return "worker_route_table"
+}
+
+func (ia *InfrastructureAdapter) securityGroupName() string {
+	return fmt.Sprintf("%s-workers", ia.TechnicalName())
+}
+
+func (ia *InfrastructureAdapter) applicationSecurityGroupName(name string) string {
+	return fmt.Sprintf("%s%s", ia.applicationSecurityGroupPrefix(), name)
+}
+
+func (ia *InfrastructureAdapter) applicationSecurityGroupPrefix() string {
+	return fmt.Sprintf("%s-asg-", ia.TechnicalName())
+}
+
+// securityRuleName returns the name of a security rule configured for the shoot. The prefix distinguishes the rules
+// from the ones managed by the cloud-controller-manager.
+func securityRuleName(name string) string {
+	return securityRulePrefix + name
+}
+
 func (ia *InfrastructureAdapter) natGatewayName() string {
 	return fmt.Sprintf("%s-nat-gateway", ia.TechnicalName())
 }
@@ -312,6 +504,28 @@ func (ia *InfrastructureAdapter) subnetName(zone *int32) string {
 	return n
 }
 
+// subnetNameForZone returns the name of the subnet of the given zone. The zone which took over the subnet of the single
+// subnet layout keeps its name, as the subnet still contains the existing machines.
+func (ia *InfrastructureAdapter) subnetNameForZone(zone int32, migrated bool) string {
+	if migrated {
+		return ia.subnetName(nil)
+	}
+
+	return ia.subnetName(&zone)
+}
+
+func (ia *InfrastructureAdapter) additionalSubnetName(name string) string {
+	return fmt.Sprintf("%s%s", ia.additionalSubnetPrefix(), name)
+}
+
+func (ia *InfrastructureAdapter) additionalSubnetPrefix() string {
+	return fmt.Sprintf("%s-subnet-", ia.TechnicalName())
+}
+
+func (ia *InfrastructureAdapter) managedIdentityName() string {
+	return fmt.Sprintf("%s-identity", ia.TechnicalName())
+}
+
 func (ia *InfrastructureAdapter) publicIPName(natName string) string {
 	return fmt.Sprintf("%s-ip", natName)
 }
@@ -335,7 +549,7 @@ func (ia *InfrastructureAdapter) zonesConfig() []ZoneConfig {
 			Subnet: SubnetConfig{
 				AzureResourceMetadata: AzureResourceMetadata{
 					ResourceGroup: ia.vnetConfig.ResourceGroup,
-					Name:          ia.subnetName(&configZone.Name),
+					Name:          ia.subnetNameForZone(configZone.Name, isMigratedZone),
 					Parent:        ia.vnetConfig.Name,
 					Kind:          KindSubnet,
 				},
@@ -457,6 +671,44 @@ func (ia *InfrastructureAdapter) defaultZone() []ZoneConfig {
 	return []ZoneConfig{z}
 }
 
+// AdditionalSubnets returns the target specification for the additional subnets of the worker nodes. An additional
+// subnet shares the NAT Gateway of the zone it is associated with, or the one of the nodes subnet if the shoot uses a
+// single subnet.
+func (ia *InfrastructureAdapter) AdditionalSubnets() []AdditionalSubnetConfig {
+	var res []AdditionalSubnetConfig
+	for _, additionalSubnet := range ia.config.Networks.AdditionalSubnets {
+		cfg := AdditionalSubnetConfig{
+			Name: additionalSubnet.Name,
+			Subnet: SubnetConfig{
+				AzureResourceMetadata: AzureResourceMetadata{
+					ResourceGroup: ia.vnetConfig.ResourceGroup,
+					Name:          ia.additionalSubnetName(additionalSubnet.Name),
+					Parent:        ia.vnetConfig.Name,
+					Kind:          KindSubnet,
+				},
+				cidr:            additionalSubnet.CIDR,
+				serviceEndpoint: additionalSubnet.ServiceEndpoints,
+			},
+		}
+
+		if additionalSubnet.Zone != nil {
+			zoneString := helper.InfrastructureZoneToString(*additionalSubnet.Zone)
+			cfg.Subnet.zone = &zoneString
+			for _, z := range ia.zoneConfigs {
+				if z.Subnet.zone != nil && *z.Subnet.zone == zoneString {
+					cfg.NatGateway = z.NatGateway
+				}
+			}
+		} else if len(ia.config.Networks.Zones) == 0 {
+			cfg.NatGateway = ia.zoneConfigs[0].NatGateway
+		}
+
+		res = append(res, cfg)
+	}
+
+	return res
+}
+
 // ManagedIpConfigs returns a filtered list of only the public IPs that are managed by gardener.
 func (ia *InfrastructureAdapter) ManagedIpConfigs() map[string]PublicIPConfig {
 	res := make(map[string]PublicIPConfig)
@@ -501,6 +753,34 @@ func (ia *InfrastructureAdapter) NatGatewayConfigs() map[string]NatGatewayConfig
 	return res
 }
 
+// MatchesNamingConvention returns true if the name of a resource of the given kind follows the naming conventions used
+// for the resources that are created for the shoot. Resources which exist once per zone are matched by their common prefix,
+// so that resources of zones that were removed from the configuration are matched as well.
+func (ia *InfrastructureAdapter) MatchesNamingConvention(kind AzureResourceKind, name string) bool {
+	switch kind {
+	case KindVirtualNetwork:
+		return ia.vnetConfig.Managed && name == ia.vnetConfig.Name
+	case KindAvailabilitySet:
+		return name == ia.availabilitySetName()
+	case KindRouteTable:
+		return name == ia.routeTableName()
+	case KindSecurityGroup:
+		return name == ia.securityGroupName()
+	case KindNatGateway:
+		return strings.HasPrefix(name, ia.natGatewayName())
+	case KindPublicIP:
+		return strings.HasPrefix(name, ia.natGatewayName()) && strings.HasSuffix(name, "-ip")
+	case KindSubnet:
+		return strings.HasPrefix(name, ia.subnetName(nil)) || strings.HasPrefix(name, ia.additionalSubnetPrefix())
+	case KindManagedIdentity:
+		return name == ia.managedIdentityName()
+	case KindApplicationSecurityGroup:
+		return strings.HasPrefix(name, ia.applicationSecurityGroupPrefix())
+	default:
+		return false
+	}
+}
+
 // HasShootPrefix returns true if the target resource's name is prefixed with the shoot's canonical name.
 func (ia *InfrastructureAdapter) HasShootPrefix(name *string) bool {
 	if name == nil {
@@ -635,6 +915,28 @@ func (r *SecurityGroupConfig) ToProvider(base *armnetwork.SecurityGroup) *armnet
 		desired.Properties = base.Properties
 	}
 
+	// replace the rules configured for the shoot and keep the ones of the cloud-controller-manager.
+	rules := make([]*armnetwork.SecurityRule, 0, len(desired.Properties.SecurityRules)+len(r.Rules))
+	for _, rule := range desired.Properties.SecurityRules {
+		if rule != nil && !strings.HasPrefix(pointer.StringDeref(rule.Name, ""), securityRulePrefix) {
+			rules = append(rules, rule)
+		}
+	}
+	desired.Properties.SecurityRules = append(rules, r.Rules...)
+
+	return desired
+}
+
+// ToProvider translates the config into the actual provider object.
+func (a *ApplicationSecurityGroupConfig) ToProvider(base *armnetwork.ApplicationSecurityGroup) *armnetwork.ApplicationSecurityGroup {
+	desired := &armnetwork.ApplicationSecurityGroup{
+		Location: to.Ptr(a.Location),
+		Name:     to.Ptr(a.Name),
+	}
+	if base != nil {
+		desired.Tags = base.Tags
+	}
+
 	return desired
 }
 
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+)
+
+const (
+	// ResourceLockName is the name of the management locks that protect the infrastructure resources.
+	ResourceLockName  = "gardener-infrastructure"
+	resourceLockNotes = "Managed by Gardener. The lock is removed automatically when the shoot is deleted."
+)
+
+// lockedKinds are the kinds of resources that are protected by a management lock if resource locks are enabled.
+var lockedKinds = []AzureResourceKind{KindVirtualNetwork, KindSubnet, KindNatGateway, KindSecurityGroup}
+
+// ResourceLockID returns the id of the management lock placed on the resource with the given id.
+func ResourceLockID(scope string) string {
+	return fmt.Sprintf(TemplateManagementLock, scope, ResourceLockName)
+}
+
+// EnsureResourceLocks places a CanNotDelete lock on the critical infrastructure resources if resource locks are
+// enabled. Locks which are no longer desired, e.g. because the feature was disabled, are removed.
+func (f *FlowContext) EnsureResourceLocks(ctx context.Context) error {
+	var (
+		log      = f.LogFromContext(ctx)
+		desired  = map[string]string{}
+		existing = f.inventory.IDsByKind(KindManagementLock)
+		joinErr  error
+	)
+	// resource ids are case-insensitive.
+	for _, scope := range f.resourceLockScopes() {
+		desired[strings.ToLower(scope)] = scope
+	}
+	if len(desired) == 0 && len(existing) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.ManagementLock()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range existing {
+		scope := f.inventory.Get(id).Parent.String()
+		if _, ok := desired[strings.ToLower(scope)]; ok {
+			continue
+		}
+		log.Info("removing resource lock because it is not needed", "Scope", scope)
+		if err := c.Delete(ctx, scope, ResourceLockName); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		f.inventory.Delete(id)
+	}
+
+	for _, scope := range desired {
+		log.V(2).Info("reconciling resource lock", "Scope", scope)
+		if _, err := c.CreateOrUpdate(ctx, scope, ResourceLockName, locks.ManagementLockObject{
+			ManagementLockProperties: &locks.ManagementLockProperties{
+				Level: locks.CanNotDelete,
+				Notes: to.Ptr(resourceLockNotes),
+			},
+		}); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		if err := f.inventory.Insert(ResourceLockID(scope)); err != nil {
+			joinErr = errors.Join(joinErr, err)
+		}
+	}
+	return joinErr
+}
+
+// resourceLockScopes returns the ids of the resources which should be locked. The resource group is not locked itself,
+// as the lock would be inherited by the machines, disks and load balancers. Instead, the security group is locked: it
+// always lives in the shoot's resource group and Azure refuses to delete a resource group containing a locked resource.
+func (f *FlowContext) resourceLockScopes() []string {
+	if f.cfg.ResourceLocks == nil || !f.cfg.ResourceLocks.Enabled {
+		return nil
+	}
+
+	var (
+		res     []string
+		vnetCfg = f.adapter.VirtualNetworkConfig()
+	)
+	// the user-provided vnet is not ours to protect, but the subnets we created in it are.
+	if vnetCfg.Managed {
+		res = append(res, GetIdFromTemplate(TemplateVirtualNetwork, f.auth.SubscriptionID, vnetCfg.ResourceGroup, vnetCfg.Name))
+	}
+	for _, z := range f.adapter.Zones() {
+		res = append(res, GetIdFromTemplateWithParent(TemplateSubnet, f.auth.SubscriptionID, vnetCfg.ResourceGroup, vnetCfg.Name, z.Subnet.Name))
+	}
+	for _, s := range f.adapter.AdditionalSubnets() {
+		res = append(res, GetIdFromTemplateWithParent(TemplateSubnet, f.auth.SubscriptionID, vnetCfg.ResourceGroup, vnetCfg.Name, s.Subnet.Name))
+	}
+	for name, nat := range f.adapter.NatGatewayConfigs() {
+		res = append(res, GetIdFromTemplate(TemplateNatGateway, f.auth.SubscriptionID, nat.ResourceGroup, name))
+	}
+	sgCfg := f.adapter.SecurityGroupConfig()
+	res = append(res, GetIdFromTemplate(TemplateSecurityGroup, f.auth.SubscriptionID, sgCfg.ResourceGroup, sgCfg.Name))
+	return res
+}
+
+// DeleteResourceLocks removes all management locks tracked in the inventory.
+func (f *FlowContext) DeleteResourceLocks(ctx context.Context) error {
+	return f.removeResourceLocks(ctx, func(string) bool { return true })
+}
+
+// removeResourceLocksFor removes the tracked locks which prevent the deletion of the resource with the given id. Since
+// locks are inherited, these are the locks placed on the resource itself, on its parents and on its children.
+func (f *FlowContext) removeResourceLocksFor(ctx context.Context, id string) error {
+	return f.removeResourceLocks(ctx, func(scope string) bool {
+		return isSelfOrParent(scope, id) || isSelfOrParent(id, scope)
+	})
+}
+
+func (f *FlowContext) removeResourceLocks(ctx context.Context, match func(scope string) bool) error {
+	var (
+		log     = f.LogFromContext(ctx)
+		ids     = f.inventory.IDsByKind(KindManagementLock)
+		joinErr error
+	)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.ManagementLock()
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		scope := f.inventory.Get(id).Parent.String()
+		if !match(scope) {
+			continue
+		}
+		log.Info("removing resource lock", "Scope", scope)
+		if err := c.Delete(ctx, scope, ResourceLockName); err != nil {
+			joinErr = errors.Join(joinErr, err)
+			continue
+		}
+		f.inventory.Delete(id)
+	}
+	return joinErr
+}
+
+// rediscoverResourceLocks adds our locks placed on resources below the given scope to the inventory.
+func (f *FlowContext) rediscoverResourceLocks(ctx context.Context, scope string) error {
+	c, err := f.factory.ManagementLock()
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.List(ctx, scope)
+	if err != nil {
+		return err
+	}
+	for _, lock := range existing {
+		if lock.ID == nil || lock.Name == nil || *lock.Name != ResourceLockName {
+			continue
+		}
+		id, err := arm.ParseResourceID(*lock.ID)
+		if err != nil {
+			return err
+		}
+		if !f.isLockedResource(id.Parent) {
+			continue
+		}
+		if err := f.inventory.Insert(*lock.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FlowContext) isLockedResource(id *arm.ResourceID) bool {
+	if id == nil {
+		return false
+	}
+	for _, kind := range lockedKinds {
+		if strings.EqualFold(id.ResourceType.String(), kind.String()) {
+			return f.adapter.MatchesNamingConvention(kind, id.Name)
+		}
+	}
+	return false
+}
+
+// isSelfOrParent returns true if the resource with id parent is the resource with id child or one of its parents.
+// Resource ids are compared case-insensitively as Azure does.
+func isSelfOrParent(parent, child string) bool {
+	parent, child = strings.ToLower(parent), strings.ToLower(child)
+	return parent == child || strings.HasPrefix(child, parent+"/")
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ResourceLocks", func() {
+	const name = "shoot--foo--bar"
+
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		factory *mockclient.MockFactory
+		lockCli *mockclient.MockManagementLock
+		infra   *extensionsv1alpha1.Infrastructure
+
+		vnetLock   = infraflow.ResourceLockID(vnetID)
+		subnetLock = infraflow.ResourceLockID(subnetID)
+		natLock    = infraflow.ResourceLockID(natID)
+		dmzID      = vnetID + "/subnets/" + name + "-subnet-dmz"
+		dmzLock    = infraflow.ResourceLockID(dmzID)
+		sgID       = rgID + "/providers/Microsoft.Network/networkSecurityGroups/" + name + "-workers"
+		sgLock     = infraflow.ResourceLockID(sgID)
+	)
+
+	newFlowContext := func(enabled bool, items ...string) *infraflow.FlowContext {
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": {"workers": "10.250.0.0/16", "natGateway": {"enabled": true}, "additionalSubnets": [{"name": "dmz", "cidr": "10.251.0.0/24"}]},
+"resourceLocks": {"enabled": %t}
+}`, enabled))}
+
+		state := &azure.InfrastructureState{}
+		for _, id := range items {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+		fctx, err := infraflow.NewFlowContext(factory, &internal.ClientAuth{SubscriptionID: "sub"}, logr.Discard(), infra, &controller.Cluster{}, state, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		raw, err := fctx.GetInfrastructureState()
+		Expect(err).NotTo(HaveOccurred())
+		return raw.Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		lockCli = mockclient.NewMockManagementLock(ctrl)
+		factory.EXPECT().ManagementLock().Return(lockCli, nil).AnyTimes()
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureResourceLocks", func() {
+		It("should lock the vnet, the subnets, the NAT gateways and the security group", func() {
+			for _, scope := range []string{vnetID, subnetID, dmzID, natID, sgID} {
+				lockCli.EXPECT().CreateOrUpdate(gomock.Any(), scope, infraflow.ResourceLockName, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _, _ string, lock locks.ManagementLockObject) (*locks.ManagementLockObject, error) {
+						Expect(lock.Level).To(Equal(locks.CanNotDelete))
+						return &lock, nil
+					})
+			}
+
+			fctx := newFlowContext(true, rgID)
+			Expect(fctx.EnsureResourceLocks(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rgID},
+				v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: vnetLock},
+				v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: subnetLock},
+				v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: dmzLock},
+				v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: natLock},
+				v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: sgLock},
+			))
+		})
+
+		It("should remove the locks once the feature is disabled", func() {
+			for _, scope := range []string{vnetID, natID} {
+				lockCli.EXPECT().Delete(gomock.Any(), scope, infraflow.ResourceLockName)
+			}
+
+			fctx := newFlowContext(false, rgID, vnetLock, natLock)
+			Expect(fctx.EnsureResourceLocks(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ConsistOf(
+				v1alpha1.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rgID},
+			))
+		})
+
+		It("should not call the API if the feature was never enabled", func() {
+			fctx := newFlowContext(false, rgID)
+			Expect(fctx.EnsureResourceLocks(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should remove the locks before deleting the resource group", func() {
+			groups := mockclient.NewMockResourceGroup(ctrl)
+			factory.EXPECT().Group().Return(groups, nil)
+			gomock.InOrder(
+				lockCli.EXPECT().Delete(gomock.Any(), vnetID, infraflow.ResourceLockName),
+				groups.EXPECT().Delete(gomock.Any(), name),
+			)
+
+			fctx := newFlowContext(true, rgID, vnetID, vnetLock)
+			Expect(fctx.Delete(ctx)).To(Succeed())
+		})
+	})
+})
@@ -19,14 +19,22 @@ func (a AzureResourceKind) String() string {
 }
 
 const (
+	// KindApplicationSecurityGroup is the kind for an application security group.
+	KindApplicationSecurityGroup AzureResourceKind = "Microsoft.Network/applicationSecurityGroups"
 	// KindAvailabilitySet is the kind for an availability set.
 	KindAvailabilitySet AzureResourceKind = "Microsoft.Compute/availabilitySets"
+	// KindManagedIdentity is the kind for a user-assigned managed identity.
+	KindManagedIdentity AzureResourceKind = "Microsoft.ManagedIdentity/userAssignedIdentities"
+	// KindManagementLock is the kind for a management lock.
+	KindManagementLock AzureResourceKind = "Microsoft.Authorization/locks"
 	// KindNatGateway is the kind for a NAT Gateway.
 	KindNatGateway AzureResourceKind = "Microsoft.Network/natGateways"
 	// KindPublicIP is the kind for a public ip.
 	KindPublicIP AzureResourceKind = "Microsoft.Network/publicIPAddresses"
 	// KindResourceGroup is the kind for a resource group.
 	KindResourceGroup AzureResourceKind = "Microsoft.Resources/resourceGroups"
+	// KindRoleAssignment is the kind for a role assignment.
+	KindRoleAssignment AzureResourceKind = "Microsoft.Authorization/roleAssignments"
 	// KindRouteTable is the kind for a route table.
 	KindRouteTable AzureResourceKind = "Microsoft.Network/routeTables"
 	// KindSecurityGroup is the kind for a security group.
@@ -38,14 +46,26 @@ const (
 )
 
 const (
+	// TemplateApplicationSecurityGroup is the template for the id of an application security group.
+	TemplateApplicationSecurityGroup = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s"
 	// TemplateAvailabilitySet the template for the ID of an availability set.
 	TemplateAvailabilitySet = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s"
+	// TemplateManagedIdentity is the template for the id of a user-assigned managed identity.
+	TemplateManagedIdentity = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s"
+	// TemplateManagementLock is the template for the id of a management lock. Locks are extension resources, hence their id
+	// is composed of the id of the locked resource and the name of the lock.
+	TemplateManagementLock = "%s/providers/Microsoft.Authorization/locks/%s"
 	// TemplateNatGateway the template for the id of a NAT Gateway.
 	TemplateNatGateway = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s"
 	// TemplatePublicIP the template for the id of a public IP.
 	TemplatePublicIP = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s"
 	// TemplateResourceGroup is the template for the id of a resource group.
 	TemplateResourceGroup = "/subscriptions/%s/resourceGroups/%s"
+	// TemplateRoleAssignment is the template for the id of a role assignment. Like locks, role assignments are extension
+	// resources of the scope they apply to.
+	TemplateRoleAssignment = "%s/providers/Microsoft.Authorization/roleAssignments/%s"
+	// TemplateRoleDefinition is the template for the id of a role definition.
+	TemplateRoleDefinition = "/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s"
 	// TemplateRouteTable is the template for the id of a route table.
 	TemplateRouteTable = "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/routeTables/%s"
 	// TemplateSecurityGroup is the template for the id of a security group.
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	consts "github.com/gardener/gardener-extension-provider-azure/pkg/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
+)
+
+// foreignOwnerTags are tags set by other components on resources in the shoot's resource group. Resources carrying
+// any of them are never part of the infrastructure inventory even if their name matches our naming conventions.
+var foreignOwnerTags = []string{
+	// set by the cloud-controller-manager e.g. for public IPs of load balancers.
+	"k8s-azure-service",
+	"k8s-azure-cluster-name",
+	// set by the worker controller on VMSS Flex objects.
+	consts.MachineSetTagKey,
+}
+
+// RediscoverInventory rebuilds the inventory from the resources that exist in Azure. It is meant for the case where the
+// infrastructure state was lost (see IsStateLost). Resources are identified by the naming conventions of the
+// InfrastructureAdapter, as the flow does not tag the resources it creates. Tags are only used to exclude resources of
+// other components which follow the same naming conventions. The rebuilt state is persisted before it is returned to
+// the caller.
+func (f *FlowContext) RediscoverInventory(ctx context.Context) error {
+	log := f.LogFromContext(ctx)
+
+	if err := f.rediscoverResourceGroup(ctx); err != nil {
+		return err
+	}
+	if err := f.rediscoverSubnets(ctx); err != nil {
+		return err
+	}
+	if err := f.rediscoverLocks(ctx); err != nil {
+		return err
+	}
+	if err := f.rediscoverRoleAssignments(ctx); err != nil {
+		return err
+	}
+
+	items := f.inventory.ToList()
+	if len(items) == 0 {
+		return nil
+	}
+
+	log.Info("rediscovered infrastructure resources", "count", len(items))
+	// inventory objects do not bump the whiteboard's generation.
+	f.forceGen()
+	return f.PersistState(ctx, true)
+}
+
+func (f *FlowContext) rediscoverResourceGroup(ctx context.Context) error {
+	c, err := f.factory.Group()
+	if err != nil {
+		return err
+	}
+
+	rg, err := c.Get(ctx, f.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+	if rg == nil {
+		return nil
+	}
+	if err := f.inventory.Insert(*rg.ID); err != nil {
+		return err
+	}
+	f.whiteboard.GetChild(ChildKeyIDs).Set(KindResourceGroup.String(), *rg.ID)
+
+	resources, err := c.ListResources(ctx, f.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+	for _, r := range Filter(resources, f.isRediscoverableResource) {
+		if err := f.inventory.Insert(*r.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FlowContext) isRediscoverableResource(r *armresources.GenericResourceExpanded) bool {
+	if r.ID == nil || r.Name == nil || r.Type == nil {
+		return false
+	}
+	for _, tag := range foreignOwnerTags {
+		if _, ok := r.Tags[tag]; ok {
+			return false
+		}
+	}
+
+	for _, kind := range []AzureResourceKind{KindVirtualNetwork, KindAvailabilitySet, KindRouteTable, KindSecurityGroup, KindNatGateway, KindPublicIP, KindManagedIdentity, KindApplicationSecurityGroup} {
+		if strings.EqualFold(*r.Type, kind.String()) {
+			return f.adapter.MatchesNamingConvention(kind, *r.Name)
+		}
+	}
+	return false
+}
+
+func (f *FlowContext) rediscoverSubnets(ctx context.Context) error {
+	vnetCfg := f.adapter.VirtualNetworkConfig()
+	// subnets of a managed vnet can only exist if the vnet itself was found.
+	if vnetCfg.Managed && len(f.inventory.ByKind(KindVirtualNetwork)) == 0 {
+		return nil
+	}
+
+	c, err := f.factory.Subnet()
+	if err != nil {
+		return err
+	}
+	subnets, err := c.List(ctx, vnetCfg.ResourceGroup, vnetCfg.Name)
+	if err != nil {
+		// the foreign vnet is validated on reconciliation. If it is gone, there is nothing left to rediscover.
+		return client.FilterNotFoundError(err)
+	}
+
+	for _, s := range subnets {
+		if s.ID == nil || s.Name == nil || !f.adapter.MatchesNamingConvention(KindSubnet, *s.Name) {
+			continue
+		}
+		if err := f.inventory.Insert(*s.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rediscoverLocks adds the management locks of the rediscovered resources to the inventory. Without them, the locked
+// resources could not be deleted.
+func (f *FlowContext) rediscoverLocks(ctx context.Context) error {
+	// do not require permissions for locks unless the feature is used.
+	if f.cfg.ResourceLocks == nil || !f.cfg.ResourceLocks.Enabled {
+		return nil
+	}
+
+	scopes := f.inventory.IDsByKind(KindResourceGroup)
+	// subnets in a user-provided vnet are not part of the shoot's resource group.
+	if subnets := f.inventory.IDsByKind(KindSubnet); !f.adapter.VirtualNetworkConfig().Managed && len(subnets) > 0 {
+		scopes = append(scopes, f.inventory.Get(subnets[0]).Parent.String())
+	}
+
+	for _, scope := range scopes {
+		if err := f.rediscoverResourceLocks(ctx, scope); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isAuthError returns true if the error is caused by invalid or insufficient credentials.
+func isAuthError(err error) bool {
+	codes := helper.DetermineErrorCodes(err)
+	return slices.Contains(codes, gardencorev1beta1.ErrorInfraUnauthenticated) || slices.Contains(codes, gardencorev1beta1.ErrorInfraUnauthorized)
+}
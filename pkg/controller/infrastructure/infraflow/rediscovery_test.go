@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	armlocks "github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+var _ = Describe("RediscoverInventory", func() {
+	const (
+		name = "shoot--foo--bar"
+		rg   = "/subscriptions/sub/resourceGroups/" + name
+	)
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		factory  *mockclient.MockFactory
+		groups   *mockclient.MockResourceGroup
+		subnets  *mockclient.MockSubnet
+		locks    *mockclient.MockManagementLock
+		infra    *extensionsv1alpha1.Infrastructure
+		persists []*runtime.RawExtension
+	)
+
+	resource := func(kind infraflow.AzureResourceKind, name string, tags map[string]*string) *armresources.GenericResourceExpanded {
+		return &armresources.GenericResourceExpanded{
+			ID:   to.Ptr(rg + "/providers/" + kind.String() + "/" + name),
+			Name: to.Ptr(name),
+			Type: to.Ptr(kind.String()),
+			Tags: tags,
+		}
+	}
+
+	newFlowContext := func(state *azure.InfrastructureState) *infraflow.FlowContext {
+		fctx, err := infraflow.NewFlowContext(factory, nil, logr.Discard(), infra, &controller.Cluster{}, state,
+			func(_ context.Context, state *runtime.RawExtension) error {
+				persists = append(persists, state)
+				return nil
+			})
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		groups = mockclient.NewMockResourceGroup(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().Group().Return(groups, nil).AnyTimes()
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+		locks = mockclient.NewMockManagementLock(ctrl)
+		factory.EXPECT().ManagementLock().Return(locks, nil).AnyTimes()
+		persists = nil
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region: "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": {"workers": "10.250.0.0/16", "natGateway": {"enabled": true}},
+"resourceLocks": {"enabled": true}
+}`)},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should rebuild the inventory from the resource group and persist it", func() {
+		groups.EXPECT().Get(gomock.Any(), name).Return(&armresources.ResourceGroup{ID: to.Ptr(rg)}, nil)
+		groups.EXPECT().ListResources(gomock.Any(), name).Return([]*armresources.GenericResourceExpanded{
+			resource(infraflow.KindVirtualNetwork, name, nil),
+			resource(infraflow.KindSecurityGroup, name+"-workers", nil),
+			resource(infraflow.KindRouteTable, "worker_route_table", nil),
+			resource(infraflow.KindNatGateway, name+"-nat-gateway", nil),
+			resource(infraflow.KindPublicIP, name+"-nat-gateway-ip", nil),
+			// owned by the cloud-controller-manager
+			resource(infraflow.KindPublicIP, name+"-nat-gateway-lb-ip", map[string]*string{"k8s-azure-service": to.Ptr("default/svc")}),
+			// not following our naming conventions
+			resource(infraflow.KindSecurityGroup, "foo", nil),
+			resource("Microsoft.Compute/virtualMachines", name+"-worker-abc", nil),
+		}, nil)
+		subnets.EXPECT().List(gomock.Any(), name, name).Return([]*armnetwork.Subnet{
+			{ID: to.Ptr(rg + "/providers/Microsoft.Network/virtualNetworks/" + name + "/subnets/" + name + "-nodes"), Name: to.Ptr(name + "-nodes")},
+			{ID: to.Ptr(rg + "/providers/Microsoft.Network/virtualNetworks/" + name + "/subnets/foo"), Name: to.Ptr("foo")},
+		}, nil)
+		vnetLock := rg + "/providers/Microsoft.Network/virtualNetworks/" + name + "/providers/Microsoft.Authorization/locks/" + infraflow.ResourceLockName
+		locks.EXPECT().List(gomock.Any(), rg).Return([]armlocks.ManagementLockObject{
+			{ID: to.Ptr(vnetLock), Name: to.Ptr(infraflow.ResourceLockName)},
+			// placed by someone else
+			{ID: to.Ptr(rg + "/providers/Microsoft.Network/natGateways/" + name + "-nat-gateway/providers/Microsoft.Authorization/locks/foo"), Name: to.Ptr("foo")},
+		}, nil)
+
+		fctx := newFlowContext(&azure.InfrastructureState{})
+		Expect(fctx.RediscoverInventory(ctx)).To(Succeed())
+
+		Expect(persists).To(HaveLen(1))
+		state := persists[0].Object.(*v1alpha1.InfrastructureState)
+		Expect(state.Version).To(Equal(infraflow.CurrentStateVersion))
+		Expect(state.ManagedItems).To(ConsistOf(
+			v1alpha1.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rg},
+			v1alpha1.AzureResource{Kind: infraflow.KindVirtualNetwork.String(), ID: rg + "/providers/Microsoft.Network/virtualNetworks/" + name},
+			v1alpha1.AzureResource{Kind: infraflow.KindSecurityGroup.String(), ID: rg + "/providers/Microsoft.Network/networkSecurityGroups/" + name + "-workers"},
+			v1alpha1.AzureResource{Kind: infraflow.KindRouteTable.String(), ID: rg + "/providers/Microsoft.Network/routeTables/worker_route_table"},
+			v1alpha1.AzureResource{Kind: infraflow.KindNatGateway.String(), ID: rg + "/providers/Microsoft.Network/natGateways/" + name + "-nat-gateway"},
+			v1alpha1.AzureResource{Kind: infraflow.KindPublicIP.String(), ID: rg + "/providers/Microsoft.Network/publicIPAddresses/" + name + "-nat-gateway-ip"},
+			v1alpha1.AzureResource{Kind: infraflow.KindSubnet.String(), ID: rg + "/providers/Microsoft.Network/virtualNetworks/" + name + "/subnets/" + name + "-nodes"},
+			v1alpha1.AzureResource{Kind: infraflow.KindManagementLock.String(), ID: vnetLock},
+		))
+	})
+
+	It("should not persist anything if the resource group does not exist", func() {
+		groups.EXPECT().Get(gomock.Any(), name).Return(nil, nil)
+
+		fctx := newFlowContext(&azure.InfrastructureState{})
+		Expect(fctx.RediscoverInventory(ctx)).To(Succeed())
+		Expect(persists).To(BeEmpty())
+	})
+
+	Describe("#IsStateLost", func() {
+		It("should not consider the state of a new infrastructure as lost", func() {
+			fctx := newFlowContext(&azure.InfrastructureState{})
+			Expect(fctx.IsStateLost()).To(BeFalse())
+		})
+
+		It("should consider the state as lost if the infrastructure was reconciled before", func() {
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus"}`)}
+
+			fctx := newFlowContext(&azure.InfrastructureState{})
+			Expect(fctx.IsStateLost()).To(BeTrue())
+		})
+
+		It("should consider the state as lost if resources are known to exist", func() {
+			state := &azure.InfrastructureState{}
+			infraflow.StateResourcesExist.Set(state, true)
+
+			fctx := newFlowContext(state)
+			Expect(fctx.IsStateLost()).To(BeTrue())
+		})
+
+		It("should not consider the state as lost if it contains resources", func() {
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureStatus"}`)}
+
+			fctx := newFlowContext(&azure.InfrastructureState{ManagedItems: []azure.AzureResource{{ID: rg}}})
+			Expect(fctx.IsStateLost()).To(BeFalse())
+		})
+	})
+
+	Describe("#Delete", func() {
+		It("should succeed without changes if nothing is found", func() {
+			groups.EXPECT().Get(gomock.Any(), name).Return(nil, nil)
+
+			fctx := newFlowContext(&azure.InfrastructureState{})
+			Expect(fctx.Delete(ctx)).To(Succeed())
+		})
+
+		It("should succeed if the credentials were never valid", func() {
+			groups.EXPECT().Get(gomock.Any(), name).Return(nil, errors.New("AuthorizationFailed"))
+
+			fctx := newFlowContext(&azure.InfrastructureState{})
+			Expect(fctx.Delete(ctx)).To(Succeed())
+		})
+
+		It("should fail if resources are known to exist but cannot be rediscovered", func() {
+			groups.EXPECT().Get(gomock.Any(), name).Return(nil, errors.New("AuthorizationFailed"))
+
+			state := &azure.InfrastructureState{}
+			infraflow.StateResourcesExist.Set(state, true)
+			fctx := newFlowContext(state)
+			Expect(fctx.Delete(ctx)).To(MatchError(ContainSubstring("AuthorizationFailed")))
+		})
+	})
+})
@@ -147,8 +147,7 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 		taskCtx := logf.IntoContext(ctx, c.Log.WithValues("flow", flowName, "task", taskName))
 		err := fn(taskCtx)
 		if err != nil {
-			// don't wrap error with '%w', as otherwise the error context get lost
-			err = fmt.Errorf("failed to %s: %s", taskName, err)
+			err = &TaskError{TaskName: taskName, err: err}
 		}
 		if perr := c.PersistState(taskCtx, false); perr != nil {
 			if err != nil {
@@ -160,3 +159,20 @@ func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn)
 		return err
 	}
 }
+
+// TaskError is the error returned by a task added with the `AddTask` method.
+// It does not implement Unwrap, as otherwise the task context gets lost when the flow errors are unwrapped to their
+// causes. The original error is available via Cause.
+type TaskError struct {
+	TaskName string
+	err      error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("failed to %s: %s", e.TaskName, e.err)
+}
+
+// Cause returns the error returned by the task function.
+func (e *TaskError) Cause() error {
+	return e.err
+}
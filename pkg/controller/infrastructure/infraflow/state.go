@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// StateVersionV1 is the first versioned layout of the InfrastructureState. Only keys declared through the state
+	// schema are persisted in the data section and every managed item carries its resource kind.
+	StateVersionV1 = "v1"
+	// CurrentStateVersion is the version of the InfrastructureState written by the flow.
+	CurrentStateVersion = StateVersionV1
+)
+
+// stateSchema contains the keys of all fields that are persisted in InfrastructureState.Data.
+var stateSchema []string
+
+var (
+	// StateResourcesExist is the typed accessor for the CreatedResourcesExistKey marker.
+	StateResourcesExist = newBoolStateField(CreatedResourcesExistKey)
+)
+
+// StateField is a typed accessor for a single entry of InfrastructureState.Data.
+type StateField[T any] struct {
+	key    string
+	encode func(T) string
+	decode func(string) (T, error)
+}
+
+func newStateField[T any](key string, encode func(T) string, decode func(string) (T, error)) StateField[T] {
+	stateSchema = append(stateSchema, key)
+	return StateField[T]{key: key, encode: encode, decode: decode}
+}
+
+func newBoolStateField(key string) StateField[bool] {
+	return newStateField(key, strconv.FormatBool, strconv.ParseBool)
+}
+
+// Key returns the key of the field in the data section.
+func (s StateField[T]) Key() string {
+	return s.key
+}
+
+// Get returns the value of the field and whether it was present in the state.
+func (s StateField[T]) Get(state *azure.InfrastructureState) (T, bool, error) {
+	raw, ok := state.Data[s.key]
+	if !ok {
+		return *new(T), false, nil
+	}
+	v, err := s.decode(raw)
+	if err != nil {
+		return *new(T), true, fmt.Errorf("failed to decode state field %q: %w", s.key, err)
+	}
+	return v, true, nil
+}
+
+// Set stores the value of the field in the state.
+func (s StateField[T]) Set(state *azure.InfrastructureState, v T) {
+	if state.Data == nil {
+		state.Data = make(map[string]string)
+	}
+	state.Data[s.key] = s.encode(v)
+}
+
+// stateMigration upgrades a state from one version to the next one.
+type stateMigration struct {
+	from    string
+	to      string
+	migrate func(*azure.InfrastructureState) error
+}
+
+// stateMigrations must be ordered. Each entry is applied if the state is at its "from" version.
+var stateMigrations = []stateMigration{
+	{from: "", to: StateVersionV1, migrate: migrateStateToV1},
+}
+
+// LoadInfrastructureState decodes the given raw state and upgrades it to the CurrentStateVersion.
+func LoadInfrastructureState(raw *runtime.RawExtension) (*azure.InfrastructureState, error) {
+	state, err := helper.InfrastructureStateFromRaw(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := UpgradeInfrastructureState(state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// UpgradeInfrastructureState applies all pending migrations to the state. States of an unknown version are rejected,
+// since they were most likely written by a newer version of the extension.
+func UpgradeInfrastructureState(state *azure.InfrastructureState) error {
+	for _, m := range stateMigrations {
+		if state.Version != m.from {
+			continue
+		}
+		if err := m.migrate(state); err != nil {
+			return fmt.Errorf("failed to migrate infrastructure state from version %q to %q: %w", m.from, m.to, err)
+		}
+		state.Version = m.to
+	}
+
+	if state.Version != CurrentStateVersion {
+		return fmt.Errorf("unsupported infrastructure state version %q", state.Version)
+	}
+	return nil
+}
+
+// migrateStateToV1 drops data keys that are not part of the schema and fills in the kind of managed items from their ID.
+func migrateStateToV1(state *azure.InfrastructureState) error {
+	data := make(map[string]string)
+	for _, key := range stateSchema {
+		if v, ok := state.Data[key]; ok {
+			data[key] = v
+		}
+	}
+	state.Data = data
+
+	items := make([]azure.AzureResource, 0, len(state.ManagedItems))
+	seen := make(map[string]struct{}, len(state.ManagedItems))
+	for _, item := range state.ManagedItems {
+		if _, ok := seen[item.ID]; ok {
+			continue
+		}
+		seen[item.ID] = struct{}{}
+
+		resourceID, err := arm.ParseResourceID(item.ID)
+		if err != nil {
+			return err
+		}
+		item.Kind = resourceID.ResourceType.String()
+		items = append(items, item)
+	}
+	state.ManagedItems = items
+	return nil
+}
+
+// exportStateData returns the schema fields that are currently set on the whiteboard.
+func exportStateData(wb shared.Whiteboard) map[string]string {
+	var data map[string]string
+	for _, key := range stateSchema {
+		if v := wb.Get(key); v != nil {
+			if data == nil {
+				data = make(map[string]string)
+			}
+			data[key] = *v
+		}
+	}
+	return data
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+)
+
+const (
+	rgID     = "/subscriptions/sub/resourceGroups/shoot--foo--bar"
+	vnetID   = rgID + "/providers/Microsoft.Network/virtualNetworks/shoot--foo--bar"
+	subnetID = vnetID + "/subnets/shoot--foo--bar-nodes"
+	natID    = rgID + "/providers/Microsoft.Network/natGateways/shoot--foo--bar-nat-gateway"
+)
+
+// historical states as they have been written by previous versions of the extension.
+var (
+	unversionedState = `{
+  "apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "InfrastructureState",
+  "managedItems": [
+    {"kind": "Microsoft.Resources/resourceGroups", "id": "` + rgID + `"},
+    {"kind": "Microsoft.Network/virtualNetworks", "id": "` + vnetID + `"},
+    {"kind": "Microsoft.Network/virtualNetworks/subnets", "id": "` + subnetID + `"},
+    {"kind": "Microsoft.Network/natGateways", "id": "` + natID + `"}
+  ]
+}`
+	unversionedStateWithData = `{
+  "apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "InfrastructureState",
+  "data": {
+    "resources_exist": "true",
+    "managed_identity_id": "foo",
+    "time": "2024-03-01 10:00:00.000000000 +0000 UTC"
+  },
+  "managedItems": [
+    {"kind": "", "id": "` + rgID + `"},
+    {"kind": "Microsoft.Resources/resourceGroups", "id": "` + rgID + `"}
+  ]
+}`
+	emptyState = `{"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1", "kind": "InfrastructureState"}`
+)
+
+var _ = Describe("State", func() {
+	load := func(s string) (*azure.InfrastructureState, error) {
+		return infraflow.LoadInfrastructureState(&runtime.RawExtension{Raw: []byte(s)})
+	}
+
+	roundTrip := func(state *azure.InfrastructureState) *azure.InfrastructureState {
+		out := &v1alpha1.InfrastructureState{}
+		Expect(helper.Scheme.Convert(state, out, nil)).To(Succeed())
+		out.TypeMeta = helper.InfrastructureStateTypeMeta
+		raw, err := json.Marshal(out)
+		Expect(err).NotTo(HaveOccurred())
+
+		reloaded, err := load(string(raw))
+		Expect(err).NotTo(HaveOccurred())
+		return reloaded
+	}
+
+	Describe("#LoadInfrastructureState", func() {
+		It("should upgrade an unversioned state and keep the managed items", func() {
+			state, err := load(unversionedState)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Version).To(Equal(infraflow.CurrentStateVersion))
+			Expect(state.Data).To(BeEmpty())
+			Expect(state.ManagedItems).To(ConsistOf(
+				azure.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rgID},
+				azure.AzureResource{Kind: infraflow.KindVirtualNetwork.String(), ID: vnetID},
+				azure.AzureResource{Kind: infraflow.KindSubnet.String(), ID: subnetID},
+				azure.AzureResource{Kind: infraflow.KindNatGateway.String(), ID: natID},
+			))
+
+			Expect(roundTrip(state)).To(Equal(state))
+		})
+
+		It("should drop unknown data keys, deduplicate items and fill in missing kinds", func() {
+			state, err := load(unversionedStateWithData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state.Data).To(Equal(map[string]string{infraflow.CreatedResourcesExistKey: "true"}))
+			Expect(state.ManagedItems).To(ConsistOf(
+				azure.AzureResource{Kind: infraflow.KindResourceGroup.String(), ID: rgID},
+			))
+
+			exists, ok, err := infraflow.StateResourcesExist.Get(state)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(exists).To(BeTrue())
+
+			Expect(roundTrip(state)).To(Equal(state))
+		})
+
+		It("should upgrade an empty state", func() {
+			for _, raw := range []*runtime.RawExtension{nil, {Raw: []byte(emptyState)}} {
+				state, err := infraflow.LoadInfrastructureState(raw)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(state.Version).To(Equal(infraflow.CurrentStateVersion))
+				Expect(state.ManagedItems).To(BeEmpty())
+
+				_, ok, err := infraflow.StateResourcesExist.Get(state)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			}
+		})
+
+		It("should leave a current state untouched", func() {
+			s := `{"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1", "kind": "InfrastructureState", "version": "v1",
+"data": {"resources_exist": "true"}, "managedItems": [{"kind": "Microsoft.Resources/resourceGroups", "id": "` + rgID + `"}]}`
+			state, err := load(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(Equal(&azure.InfrastructureState{
+				Version:      infraflow.StateVersionV1,
+				Data:         map[string]string{infraflow.CreatedResourcesExistKey: "true"},
+				ManagedItems: []azure.AzureResource{{Kind: infraflow.KindResourceGroup.String(), ID: rgID}},
+			}))
+		})
+
+		It("should reject states of an unknown version", func() {
+			_, err := load(`{"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1", "kind": "InfrastructureState", "version": "v99"}`)
+			Expect(err).To(MatchError(ContainSubstring(`unsupported infrastructure state version "v99"`)))
+		})
+
+		It("should fail for managed items with an invalid ID", func() {
+			_, err := load(`{"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1", "kind": "InfrastructureState", "managedItems": [{"kind": "", "id": "foo"}]}`)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("StateField", func() {
+		It("should fail to decode invalid values", func() {
+			state := &azure.InfrastructureState{Data: map[string]string{infraflow.CreatedResourcesExistKey: "maybe"}}
+			_, ok, err := infraflow.StateResourcesExist.Get(state)
+			Expect(ok).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should set the value", func() {
+			state := &azure.InfrastructureState{}
+			infraflow.StateResourcesExist.Set(state, true)
+			Expect(state.Data).To(HaveKeyWithValue(infraflow.StateResourcesExist.Key(), "true"))
+		})
+	})
+})
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("Subnets", func() {
+	const (
+		name     = "shoot--foo--bar"
+		vnetID   = rgID + "/providers/Microsoft.Network/virtualNetworks/" + name
+		rtID     = rgID + "/providers/Microsoft.Network/routeTables/worker_route_table"
+		nsgID    = rgID + "/providers/Microsoft.Network/networkSecurityGroups/" + name + "-workers"
+		natZ1ID  = rgID + "/providers/Microsoft.Network/natGateways/" + name + "-nat-gateway-z1"
+		nodesZ1  = name + "-nodes-z1"
+		dmz      = name + "-subnet-dmz"
+		stale    = name + "-subnet-stale"
+		staleID  = vnetID + "/subnets/" + stale
+		networks = `{
+"vnet": {"cidr": "10.250.0.0/16"},
+"zones": [{"name": 1, "cidr": "10.250.0.0/24", "natGateway": {"enabled": true}}],
+"additionalSubnets": [{"name": "dmz", "cidr": "10.250.4.0/24", "zone": 1, "serviceEndpoints": ["Microsoft.Storage"]}]
+}`
+	)
+
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		subnets *mockclient.MockSubnet
+		infra   *extensionsv1alpha1.Infrastructure
+
+		factory *mockclient.MockFactory
+	)
+
+	newFlowContext := func(items ...string) *infraflow.FlowContext {
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": ` + networks + `
+}`)}
+
+		state := &azure.InfrastructureState{ManagedItems: []azure.AzureResource{{ID: rgID}, {ID: staleID}}}
+		for _, id := range items {
+			state.ManagedItems = append(state.ManagedItems, azure.AzureResource{ID: id})
+		}
+		fctx, err := infraflow.NewFlowContext(factory, &internal.ClientAuth{SubscriptionID: "sub"}, logr.Discard(), infra, &controller.Cluster{}, state, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		subnets = mockclient.NewMockSubnet(ctrl)
+		factory.EXPECT().Subnet().Return(subnets, nil).AnyTimes()
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "westeurope"},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#EnsureSubnets", func() {
+		It("should reconcile the additional subnets and delete the ones which are no longer configured", func() {
+			subnets.EXPECT().List(gomock.Any(), name, name).Return([]*armnetwork.Subnet{
+				{ID: to.Ptr(staleID), Name: to.Ptr(stale), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: to.Ptr("10.250.5.0/24")}},
+			}, nil)
+			subnets.EXPECT().Delete(gomock.Any(), name, name, stale)
+			subnets.EXPECT().CreateOrUpdate(gomock.Any(), name, name, nodesZ1, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, _ string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+					subnet.ID = to.Ptr(vnetID + "/subnets/" + nodesZ1)
+					return &subnet, nil
+				})
+			subnets.EXPECT().CreateOrUpdate(gomock.Any(), name, name, dmz, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, _ string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+					Expect(subnet.Properties.AddressPrefix).To(Equal(to.Ptr("10.250.4.0/24")))
+					Expect(subnet.Properties.ServiceEndpoints).To(ConsistOf(&armnetwork.ServiceEndpointPropertiesFormat{Service: to.Ptr("Microsoft.Storage")}))
+					Expect(subnet.Properties.RouteTable.ID).To(Equal(to.Ptr(rtID)))
+					Expect(subnet.Properties.NetworkSecurityGroup.ID).To(Equal(to.Ptr(nsgID)))
+					Expect(subnet.Properties.NatGateway.ID).To(Equal(to.Ptr(natZ1ID)))
+					subnet.ID = to.Ptr(vnetID + "/subnets/" + dmz)
+					return &subnet, nil
+				})
+
+			fctx := newFlowContext()
+			Expect(fctx.EnsureSubnets(ctx)).To(Succeed())
+
+			raw, err := fctx.GetInfrastructureState()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw.Object.(*v1alpha1.InfrastructureState).ManagedItems).NotTo(ContainElement(HaveField("ID", staleID)))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Networks.Subnets).To(Equal([]v1alpha1.Subnet{
+				{Name: nodesZ1, Purpose: v1alpha1.PurposeNodes, Zone: to.Ptr("1")},
+				{Name: dmz, Purpose: v1alpha1.PurposeAdditionalNodes, Zone: to.Ptr("1"), AdditionalSubnetName: to.Ptr("dmz")},
+			}))
+		})
+
+		It("should remove the lock of an additional subnet before deleting it", func() {
+			lockCli := mockclient.NewMockManagementLock(ctrl)
+			factory.EXPECT().ManagementLock().Return(lockCli, nil)
+			subnets.EXPECT().List(gomock.Any(), name, name).Return([]*armnetwork.Subnet{
+				{ID: to.Ptr(staleID), Name: to.Ptr(stale), Properties: &armnetwork.SubnetPropertiesFormat{AddressPrefix: to.Ptr("10.250.5.0/24")}},
+			}, nil)
+			gomock.InOrder(
+				lockCli.EXPECT().Delete(gomock.Any(), staleID, infraflow.ResourceLockName),
+				subnets.EXPECT().Delete(gomock.Any(), name, name, stale),
+			)
+			subnets.EXPECT().CreateOrUpdate(gomock.Any(), name, name, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _, subnetName string, subnet armnetwork.Subnet) (*armnetwork.Subnet, error) {
+					subnet.ID = to.Ptr(vnetID + "/subnets/" + subnetName)
+					return &subnet, nil
+				}).Times(2)
+
+			fctx := newFlowContext(infraflow.ResourceLockID(staleID))
+			Expect(fctx.EnsureSubnets(ctx)).To(Succeed())
+
+			raw, err := fctx.GetInfrastructureState()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw.Object.(*v1alpha1.InfrastructureState).ManagedItems).NotTo(ContainElement(HaveField("ID", infraflow.ResourceLockID(staleID))))
+		})
+	})
+})
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/pointer"
+)
+
+// DeleteAvailabilitySetIfEmpty deletes the availability set of a non-zonal cluster which is migrated to a zonal cluster
+// once all machines have been moved to zones. As long as it contains machines, it is kept in the infrastructure status
+// so that the worker pools which are not migrated yet remain in it and the cloud-provider-config still refers to it.
+func (f *FlowContext) DeleteAvailabilitySetIfEmpty(ctx context.Context) error {
+	log := f.LogFromContext(ctx)
+	cfg := f.adapter.ZoneMigrationAvailabilitySetConfig()
+	if cfg == nil {
+		return nil
+	}
+
+	c, err := f.factory.AvailabilitySet()
+	if err != nil {
+		return err
+	}
+
+	avset, err := c.Get(ctx, cfg.ResourceGroup, cfg.Name)
+	if err != nil {
+		return err
+	}
+	if avset == nil {
+		log.Info("availability set is already gone", "name", cfg.Name)
+		return nil
+	}
+
+	if avset.Properties != nil && len(avset.Properties.VirtualMachines) > 0 {
+		log.Info("keeping availability set as it still contains machines", "name", cfg.Name, "machines", len(avset.Properties.VirtualMachines))
+		if err := f.inventory.Insert(*avset.ID); err != nil {
+			return err
+		}
+		f.whiteboard.SetObject(KeyZoneMigrationAvailabilitySet, avset)
+		return nil
+	}
+
+	log.Info("deleting availability set as all machines have been migrated to zones", "name", cfg.Name)
+	if err := c.Delete(ctx, cfg.ResourceGroup, cfg.Name); err != nil {
+		return err
+	}
+	f.inventory.Delete(*avset.ID)
+	return nil
+}
+
+// MigrateLoadBalancersToStandardSKU moves the load balancers of a cluster, which was migrated to zones, to the standard
+// SKU. The cloud-controller-manager cannot change the SKU of existing load balancers. Therefore, the public IPs of the
+// load balancer services are made static, the basic load balancers are deleted and the released public IPs are upgraded
+// to the standard SKU. The cloud-controller-manager then recreates the load balancers with the same public IPs.
+// The step must be requested explicitly, as the services are unreachable until their load balancers are recreated, and
+// it only runs once the cloud-provider-config was switched to the standard SKU, see LoadBalancerMigrationRequested.
+func (f *FlowContext) MigrateLoadBalancersToStandardSKU(ctx context.Context) error {
+	log := f.LogFromContext(ctx)
+	if !f.adapter.LoadBalancerMigrationRequested() {
+		return nil
+	}
+
+	pipClient, err := f.factory.PublicIP()
+	if err != nil {
+		return err
+	}
+	lbClient, err := f.factory.LoadBalancer()
+	if err != nil {
+		return err
+	}
+
+	ips, err := pipClient.List(ctx, f.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+	ips = Filter(ips, func(ip *armnetwork.PublicIPAddress) bool {
+		return ip.Tags["k8s-azure-service"] != nil && ip.SKU != nil && ip.SKU.Name != nil && *ip.SKU.Name == armnetwork.PublicIPAddressSKUNameBasic
+	})
+
+	// dynamic addresses are released together with the load balancer, hence they need to become static first.
+	for _, ip := range ips {
+		if ip.Properties == nil {
+			ip.Properties = &armnetwork.PublicIPAddressPropertiesFormat{}
+		}
+		if method := ip.Properties.PublicIPAllocationMethod; method != nil && *method == armnetwork.IPAllocationMethodStatic {
+			continue
+		}
+		log.Info("changing allocation of load balancer public IP to static", "name", *ip.Name)
+		ip.Properties.PublicIPAllocationMethod = to.Ptr(armnetwork.IPAllocationMethodStatic)
+		if _, err := pipClient.CreateOrUpdate(ctx, f.adapter.ResourceGroupName(), *ip.Name, *ip); err != nil {
+			return err
+		}
+	}
+
+	lbs, err := lbClient.List(ctx, f.adapter.ResourceGroupName())
+	if err != nil {
+		return err
+	}
+	for _, lb := range lbs {
+		if lb.SKU == nil || lb.SKU.Name == nil || *lb.SKU.Name != armnetwork.LoadBalancerSKUNameBasic {
+			continue
+		}
+		if name := pointer.StringDeref(lb.Name, ""); name != f.adapter.TechnicalName() && name != f.adapter.TechnicalName()+"-internal" {
+			continue
+		}
+		log.Info("deleting basic load balancer so that it is recreated with the standard SKU", "name", *lb.Name)
+		if err := lbClient.Delete(ctx, f.adapter.ResourceGroupName(), *lb.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, ip := range ips {
+		log.Info("upgrading load balancer public IP to the standard SKU", "name", *ip.Name)
+		ip.SKU.Name = to.Ptr(armnetwork.PublicIPAddressSKUNameStandard)
+		if _, err := pipClient.CreateOrUpdate(ctx, f.adapter.ResourceGroupName(), *ip.Name, *ip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
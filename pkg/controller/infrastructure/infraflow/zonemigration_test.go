@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	mockclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+var _ = Describe("ZoneMigration", func() {
+	const (
+		name       = "shoot--foo--bar"
+		avsetName  = name + "-avset-workers"
+		avsetID    = rgID + "/providers/Microsoft.Compute/availabilitySets/" + avsetName
+		vmResource = rgID + "/providers/Microsoft.Compute/virtualMachines/machine-0"
+	)
+
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		avsets  *mockclient.MockAvailabilitySet
+		infra   *extensionsv1alpha1.Infrastructure
+		cluster *controller.Cluster
+
+		factory *mockclient.MockFactory
+	)
+
+	newFlowContext := func() *infraflow.FlowContext {
+		fctx, err := infraflow.NewFlowContext(factory, &internal.ClientAuth{SubscriptionID: "sub"}, logr.Discard(), infra, cluster, &azure.InfrastructureState{
+			ManagedItems: []azure.AzureResource{{ID: rgID}, {ID: avsetID}},
+		}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return fctx
+	}
+
+	managedItems := func(fctx *infraflow.FlowContext) []v1alpha1.AzureResource {
+		raw, err := fctx.GetInfrastructureState()
+		Expect(err).NotTo(HaveOccurred())
+		return raw.Object.(*v1alpha1.InfrastructureState).ManagedItems
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		factory = mockclient.NewMockFactory(ctrl)
+		avsets = mockclient.NewMockAvailabilitySet(ctrl)
+		factory.EXPECT().AvailabilitySet().Return(avsets, nil).AnyTimes()
+
+		cluster = &controller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: name},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				Region: "westeurope",
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": {"workers": "10.250.0.0/16"}
+}`)},
+				},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					ProviderStatus: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"availabilitySets": [{"purpose": "nodes", "id": "` + avsetID + `", "name": "` + avsetName + `", "countFaultDomains": 2, "countUpdateDomains": 5}]
+}`)},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#DeleteAvailabilitySetIfEmpty", func() {
+		It("should keep the availability set in the status as long as it contains machines", func() {
+			avsets.EXPECT().Get(gomock.Any(), name, avsetName).Return(&armcompute.AvailabilitySet{
+				ID: to.Ptr(avsetID),
+				Properties: &armcompute.AvailabilitySetProperties{
+					VirtualMachines: []*armcompute.SubResource{{ID: to.Ptr(vmResource)}},
+				},
+			}, nil)
+
+			fctx := newFlowContext()
+			Expect(fctx.DeleteAvailabilitySetIfEmpty(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).To(ContainElement(HaveField("ID", avsetID)))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Zoned).To(BeTrue())
+			Expect(status.AvailabilitySets).To(ConsistOf(v1alpha1.AvailabilitySet{
+				Purpose:            v1alpha1.PurposeNodes,
+				ID:                 avsetID,
+				Name:               avsetName,
+				CountFaultDomains:  to.Ptr[int32](2),
+				CountUpdateDomains: to.Ptr[int32](5),
+			}))
+		})
+
+		It("should delete the availability set once all machines are moved to zones", func() {
+			avsets.EXPECT().Get(gomock.Any(), name, avsetName).Return(&armcompute.AvailabilitySet{
+				ID:         to.Ptr(avsetID),
+				Properties: &armcompute.AvailabilitySetProperties{},
+			}, nil)
+			avsets.EXPECT().Delete(gomock.Any(), name, avsetName)
+
+			fctx := newFlowContext()
+			Expect(fctx.DeleteAvailabilitySetIfEmpty(ctx)).To(Succeed())
+			Expect(managedItems(fctx)).NotTo(ContainElement(HaveField("ID", avsetID)))
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.AvailabilitySets).To(BeEmpty())
+		})
+
+		It("should do nothing if the availability set is already gone", func() {
+			avsets.EXPECT().Get(gomock.Any(), name, avsetName).Return(nil, nil)
+
+			fctx := newFlowContext()
+			Expect(fctx.DeleteAvailabilitySetIfEmpty(ctx)).To(Succeed())
+
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.AvailabilitySets).To(BeEmpty())
+		})
+	})
+
+	It("should keep the subnet of the availability set for the zone which takes it over", func() {
+		infra.Annotations = map[string]string{"migration.azure.provider.extensions.gardener.cloud/zone": "2"}
+		infra.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"zoned": true,
+"networks": {"vnet": {"cidr": "10.250.0.0/15"}, "zones": [{"name": 1, "cidr": "10.251.0.0/16"}, {"name": 2, "cidr": "10.250.0.0/16", "natGateway": {"enabled": true}}]}
+}`)}
+
+		status, err := newFlowContext().GetInfrastructureStatus(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Networks.Subnets).To(ConsistOf(
+			v1alpha1.Subnet{Name: name + "-nodes-z1", Purpose: v1alpha1.PurposeNodes, Zone: to.Ptr("1")},
+			v1alpha1.Subnet{Name: name + "-nodes", Purpose: v1alpha1.PurposeNodes, Zone: to.Ptr("2"), Migrated: true},
+		))
+	})
+
+	Describe("#MigrateLoadBalancersToStandardSKU", func() {
+		var (
+			pips *mockclient.MockPublicIP
+			lbs  *mockclient.MockLoadBalancer
+		)
+
+		BeforeEach(func() {
+			pips = mockclient.NewMockPublicIP(ctrl)
+			lbs = mockclient.NewMockLoadBalancer(ctrl)
+			factory.EXPECT().PublicIP().Return(pips, nil).AnyTimes()
+			factory.EXPECT().LoadBalancer().Return(lbs, nil).AnyTimes()
+
+			cluster.Shoot.Annotations = map[string]string{"azure.provider.extensions.gardener.cloud/migrate-load-balancers": "true"}
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"zoned": true
+}`)}
+		})
+
+		publicIP := func(name string, allocation armnetwork.IPAllocationMethod) *armnetwork.PublicIPAddress {
+			return &armnetwork.PublicIPAddress{
+				Name: to.Ptr(name),
+				SKU:  &armnetwork.PublicIPAddressSKU{Name: to.Ptr(armnetwork.PublicIPAddressSKUNameBasic)},
+				Tags: map[string]*string{"k8s-azure-service": to.Ptr("default/nginx")},
+				Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+					PublicIPAllocationMethod: to.Ptr(allocation),
+				},
+			}
+		}
+
+		loadBalancer := func(name string, sku armnetwork.LoadBalancerSKUName) *armnetwork.LoadBalancer {
+			return &armnetwork.LoadBalancer{
+				Name: to.Ptr(name),
+				SKU:  &armnetwork.LoadBalancerSKU{Name: to.Ptr(sku)},
+			}
+		}
+
+		It("should recreate the basic load balancers of the cluster with their public IPs", func() {
+			dynamicIP := publicIP(name+"-dynamic", armnetwork.IPAllocationMethodDynamic)
+			staticIP := publicIP(name+"-static", armnetwork.IPAllocationMethodStatic)
+			nodesIP := &armnetwork.PublicIPAddress{
+				Name: to.Ptr(name + "-nat-gateway-ip"),
+				SKU:  &armnetwork.PublicIPAddressSKU{Name: to.Ptr(armnetwork.PublicIPAddressSKUNameStandard)},
+			}
+
+			gomock.InOrder(
+				pips.EXPECT().List(gomock.Any(), name).Return([]*armnetwork.PublicIPAddress{dynamicIP, staticIP, nodesIP}, nil),
+				pips.EXPECT().CreateOrUpdate(gomock.Any(), name, name+"-dynamic", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, ip armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+						Expect(*ip.Properties.PublicIPAllocationMethod).To(Equal(armnetwork.IPAllocationMethodStatic))
+						Expect(*ip.SKU.Name).To(Equal(armnetwork.PublicIPAddressSKUNameBasic))
+						return &ip, nil
+					}),
+				lbs.EXPECT().List(gomock.Any(), name).Return([]*armnetwork.LoadBalancer{
+					loadBalancer(name, armnetwork.LoadBalancerSKUNameBasic),
+					loadBalancer(name+"-internal", armnetwork.LoadBalancerSKUNameBasic),
+					loadBalancer("foreign", armnetwork.LoadBalancerSKUNameBasic),
+				}, nil),
+				lbs.EXPECT().Delete(gomock.Any(), name, name),
+				lbs.EXPECT().Delete(gomock.Any(), name, name+"-internal"),
+			)
+			for _, ipName := range []string{name + "-dynamic", name + "-static"} {
+				pips.EXPECT().CreateOrUpdate(gomock.Any(), name, ipName, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _ string, ip armnetwork.PublicIPAddress) (*armnetwork.PublicIPAddress, error) {
+						Expect(*ip.Properties.PublicIPAllocationMethod).To(Equal(armnetwork.IPAllocationMethodStatic))
+						Expect(*ip.SKU.Name).To(Equal(armnetwork.PublicIPAddressSKUNameStandard))
+						return &ip, nil
+					})
+			}
+
+			Expect(newFlowContext().MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+		})
+
+		It("should keep load balancers which already use the standard SKU", func() {
+			pips.EXPECT().List(gomock.Any(), name).Return(nil, nil)
+			lbs.EXPECT().List(gomock.Any(), name).Return([]*armnetwork.LoadBalancer{
+				loadBalancer(name, armnetwork.LoadBalancerSKUNameStandard),
+			}, nil)
+
+			Expect(newFlowContext().MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+		})
+
+		It("should do nothing if the migration is not requested", func() {
+			cluster.Shoot.Annotations = nil
+
+			Expect(newFlowContext().MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+		})
+
+		It("should only migrate the load balancers in the reconciliation after the availability set was deleted", func() {
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureStatus",
+"zoned": true,
+"availabilitySets": [{"purpose": "nodes", "id": "` + avsetID + `", "name": "` + avsetName + `", "countFaultDomains": 2, "countUpdateDomains": 5}]
+}`)}
+
+			By("keeping the load balancers while the availability set still contains machines")
+			avsets.EXPECT().Get(gomock.Any(), name, avsetName).Return(&armcompute.AvailabilitySet{
+				ID: to.Ptr(avsetID),
+				Properties: &armcompute.AvailabilitySetProperties{
+					VirtualMachines: []*armcompute.SubResource{{ID: to.Ptr(vmResource)}},
+				},
+			}, nil)
+			fctx := newFlowContext()
+			Expect(fctx.DeleteAvailabilitySetIfEmpty(ctx)).To(Succeed())
+			Expect(fctx.MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+
+			By("keeping the load balancers in the reconciliation which deletes the availability set")
+			avsets.EXPECT().Get(gomock.Any(), name, avsetName).Return(&armcompute.AvailabilitySet{
+				ID:         to.Ptr(avsetID),
+				Properties: &armcompute.AvailabilitySetProperties{},
+			}, nil)
+			avsets.EXPECT().Delete(gomock.Any(), name, avsetName)
+			fctx = newFlowContext()
+			Expect(fctx.DeleteAvailabilitySetIfEmpty(ctx)).To(Succeed())
+			Expect(fctx.MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+
+			By("migrating the load balancers once the cloud-provider-config was switched with the new status")
+			status, err := fctx.GetInfrastructureStatus(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			raw, err := json.Marshal(status)
+			Expect(err).NotTo(HaveOccurred())
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: raw}
+
+			pips.EXPECT().List(gomock.Any(), name).Return(nil, nil)
+			lbs.EXPECT().List(gomock.Any(), name).Return([]*armnetwork.LoadBalancer{
+				loadBalancer(name, armnetwork.LoadBalancerSKUNameBasic),
+			}, nil)
+			lbs.EXPECT().Delete(gomock.Any(), name, name)
+			Expect(newFlowContext().MigrateLoadBalancersToStandardSKU(ctx)).To(Succeed())
+		})
+	})
+})
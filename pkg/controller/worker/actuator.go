@@ -10,23 +10,30 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
-	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionssecretsmanager "github.com/gardener/gardener/extensions/pkg/util/secret/manager"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	api "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
+// secretsManagerIdentity is the identity of the secrets manager which generates the secrets of the worker pools.
+const secretsManagerIdentity = azure.Name + "-worker"
+
 type delegateFactory struct {
 	seedClient   client.Client
 	restConfig   *rest.Config
@@ -50,7 +57,7 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster) worker.Actu
 		gardenCluster,
 		workerDelegate,
 		func(err error) []gardencorev1beta1.ErrorCode {
-			return util.DetermineErrorCodes(err, helper.KnownCodes)
+			return helper.DetermineErrorCodes(err)
 		},
 	)
 }
@@ -75,7 +82,12 @@ func (d *delegateFactory) WorkerDelegate(ctx context.Context, worker *extensions
 		return nil, err
 	}
 
-	return NewWorkerDelegate(d.seedClient, d.scheme, seedChartApplier, serverVersion.GitVersion, worker, cluster, factory)
+	secretsManager, err := extensionssecretsmanager.SecretsManagerForCluster(ctx, log.FromContext(ctx).WithName("secretsmanager"), clock.RealClock{}, d.seedClient, cluster, secretsManagerIdentity, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkerDelegate(d.seedClient, d.scheme, seedChartApplier, serverVersion.GitVersion, worker, cluster, factory, secretsManager)
 }
 
 type workerDelegate struct {
@@ -95,7 +107,8 @@ type workerDelegate struct {
 	machineDeployments worker.MachineDeployments
 	machineImages      []api.MachineImage
 
-	clientFactory azureclient.Factory
+	clientFactory  azureclient.Factory
+	secretsManager secretsmanager.Interface
 }
 
 // NewWorkerDelegate creates a new context for a worker reconciliation.
@@ -107,6 +120,7 @@ func NewWorkerDelegate(
 	worker *extensionsv1alpha1.Worker,
 	cluster *extensionscontroller.Cluster,
 	factory azureclient.Factory,
+	secretsManager secretsmanager.Interface,
 ) (genericactuator.WorkerDelegate, error) {
 	config, err := helper.CloudProfileConfigFromCluster(cluster)
 	if err != nil {
@@ -126,6 +140,7 @@ func NewWorkerDelegate(
 		cluster:            cluster,
 		worker:             worker,
 
-		clientFactory: factory,
+		clientFactory:  factory,
+		secretsManager: secretsManager,
 	}, nil
 }
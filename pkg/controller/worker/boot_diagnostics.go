@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/internal"
+)
+
+// mergeBootDiagnostics returns the boot diagnostics settings of a worker pool. The settings of the worker config
+// replace the defaults of the infrastructure config.
+func mergeBootDiagnostics(defaults, bootDiagnostics *azureapi.BootDiagnostics) *azureapi.BootDiagnostics {
+	if bootDiagnostics != nil {
+		return bootDiagnostics
+	}
+	return defaults
+}
+
+func computeBootDiagnostics(bootDiagnostics *azureapi.BootDiagnostics) map[string]interface{} {
+	res := map[string]interface{}{}
+	if bootDiagnostics.StorageURI != nil {
+		res["storageURI"] = *bootDiagnostics.StorageURI
+	}
+	return res
+}
+
+// findStuckMachines returns the machines which are stuck in creation as they are listed in the worker status.
+func (w *workerDelegate) findStuckMachines(ctx context.Context, resourceGroupName string) ([]azureapi.StuckMachine, error) {
+	machines, err := internal.FindStuckMachines(ctx, w.client, w.worker.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var stuckMachines []azureapi.StuckMachine
+	for _, machine := range machines {
+		stuckMachines = append(stuckMachines, azureapi.StuckMachine{
+			Name:          machine.Name,
+			ResourceGroup: resourceGroupName,
+			Phase:         string(machine.Status.CurrentStatus.Phase),
+		})
+	}
+	return stuckMachines, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/pointer"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// capacityReservationPlacement contains the capacity reservation group of a worker pool and its capacity reservations.
+type capacityReservationPlacement struct {
+	group        *armcompute.CapacityReservationGroup
+	reservations []*armcompute.CapacityReservation
+}
+
+// getCapacityReservationPlacement fetches the capacity reservation group referenced by the given configuration together
+// with all of its capacity reservations.
+func (w *workerDelegate) getCapacityReservationPlacement(ctx context.Context, capacityReservation *azureapi.CapacityReservationConfig) (*capacityReservationPlacement, error) {
+	groupID, err := arm.ParseResourceID(capacityReservation.CapacityReservationGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupClient, err := w.clientFactory.CapacityReservationGroup()
+	if err != nil {
+		return nil, err
+	}
+	group, err := groupClient.Get(ctx, groupID.ResourceGroupName, groupID.Name)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("capacity reservation group %q not found", capacityReservation.CapacityReservationGroupID), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	reservationClient, err := w.clientFactory.CapacityReservation()
+	if err != nil {
+		return nil, err
+	}
+	placement := &capacityReservationPlacement{group: group}
+	if group.Properties == nil {
+		return placement, nil
+	}
+	for _, ref := range group.Properties.CapacityReservations {
+		if ref == nil || ref.ID == nil {
+			continue
+		}
+		reservationID, err := arm.ParseResourceID(*ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		reservation, err := reservationClient.Get(ctx, reservationID.ResourceGroupName, reservationID.Parent.Name, reservationID.Name)
+		if err != nil {
+			return nil, err
+		}
+		// The reservation may have been deleted in the meantime.
+		if reservation != nil {
+			placement.reservations = append(placement.reservations, reservation)
+		}
+	}
+
+	return placement, nil
+}
+
+// validate checks that the group contains a capacity reservation for the given machine type in the given zone. An
+// empty zone refers to a regional capacity reservation.
+func (p *capacityReservationPlacement) validate(machineType, zone string) error {
+	for _, reservation := range p.reservations {
+		if reservation.SKU == nil || !strings.EqualFold(pointer.StringDeref(reservation.SKU.Name, ""), machineType) {
+			continue
+		}
+		if zone == "" && len(reservation.Zones) == 0 {
+			return nil
+		}
+		if zone != "" && slices.ContainsFunc(reservation.Zones, func(z *string) bool { return z != nil && *z == zone }) {
+			return nil
+		}
+	}
+
+	if zone == "" {
+		return v1beta1helper.NewErrorWithCodes(fmt.Errorf("capacity reservation group %q does not contain a regional capacity reservation for machine type %q", pointer.StringDeref(p.group.ID, ""), machineType), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return v1beta1helper.NewErrorWithCodes(fmt.Errorf("capacity reservation group %q does not contain a capacity reservation for machine type %q in zone %q", pointer.StringDeref(p.group.ID, ""), machineType, zone), gardencorev1beta1.ErrorConfigurationProblem)
+}
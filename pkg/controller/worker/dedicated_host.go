@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"k8s.io/utils/pointer"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// dedicatedHostPlacement contains the dedicated host group of a worker pool and the hosts its machines can be placed on.
+type dedicatedHostPlacement struct {
+	hostGroup *armcompute.DedicatedHostGroup
+	hosts     []*armcompute.DedicatedHost
+}
+
+// getDedicatedHostPlacement fetches the dedicated host group and the hosts referenced by the given configuration. The
+// hosts contain their instance view with the remaining capacity.
+func (w *workerDelegate) getDedicatedHostPlacement(ctx context.Context, dedicatedHost *azureapi.DedicatedHostConfig) (*dedicatedHostPlacement, error) {
+	hostGroupID, err := arm.ParseResourceID(dedicatedHost.HostGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	hostGroupClient, err := w.clientFactory.DedicatedHostGroup()
+	if err != nil {
+		return nil, err
+	}
+	hostGroup, err := hostGroupClient.Get(ctx, hostGroupID.ResourceGroupName, hostGroupID.Name)
+	if err != nil {
+		return nil, err
+	}
+	if hostGroup == nil {
+		return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("dedicated host group %q not found", dedicatedHost.HostGroupID), gardencorev1beta1.ErrorConfigurationProblem)
+	}
+
+	var hostIDs []string
+	if dedicatedHost.HostID != nil {
+		hostIDs = append(hostIDs, *dedicatedHost.HostID)
+	} else if hostGroup.Properties != nil {
+		for _, host := range hostGroup.Properties.Hosts {
+			if host != nil && host.ID != nil {
+				hostIDs = append(hostIDs, *host.ID)
+			}
+		}
+	}
+
+	hostClient, err := w.clientFactory.DedicatedHost()
+	if err != nil {
+		return nil, err
+	}
+	placement := &dedicatedHostPlacement{hostGroup: hostGroup}
+	for _, id := range hostIDs {
+		hostID, err := arm.ParseResourceID(id)
+		if err != nil {
+			return nil, err
+		}
+		host, err := hostClient.Get(ctx, hostID.ResourceGroupName, hostID.Parent.Name, hostID.Name, to.Ptr(armcompute.InstanceViewTypesInstanceView))
+		if err != nil {
+			return nil, err
+		}
+		if host == nil {
+			return nil, v1beta1helper.NewErrorWithCodes(fmt.Errorf("dedicated host %q not found", id), gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		placement.hosts = append(placement.hosts, host)
+	}
+
+	return placement, nil
+}
+
+// validateZone checks that the machines of the given zone can be placed on the hosts of the host group.
+func (p *dedicatedHostPlacement) validateZone(zone string) error {
+	for _, hostGroupZone := range p.hostGroup.Zones {
+		if hostGroupZone != nil && *hostGroupZone == zone {
+			return nil
+		}
+	}
+	return v1beta1helper.NewErrorWithCodes(fmt.Errorf("dedicated host group %q is not available in zone %q", pointer.StringDeref(p.hostGroup.ID, ""), zone), gardencorev1beta1.ErrorConfigurationProblem)
+}
+
+// capacity returns the number of machines of the given machine deployment which fit on the hosts, i.e. the machines
+// which are already placed on the hosts and the machines of the given type which the hosts can still allocate.
+func (p *dedicatedHostPlacement) capacity(machineType, machineDeploymentName string) int32 {
+	var capacity int32
+	for _, host := range p.hosts {
+		if host.Properties == nil {
+			continue
+		}
+
+		// The virtual machines are named after the machines, which are prefixed with the name of their machine deployment.
+		for _, vm := range host.Properties.VirtualMachines {
+			if vm == nil || vm.ID == nil {
+				continue
+			}
+			if id, err := arm.ParseResourceID(*vm.ID); err == nil && strings.HasPrefix(id.Name, machineDeploymentName+"-") {
+				capacity++
+			}
+		}
+
+		if host.Properties.InstanceView == nil || host.Properties.InstanceView.AvailableCapacity == nil {
+			continue
+		}
+		allocatableVMs := host.Properties.InstanceView.AvailableCapacity.AllocatableVMs
+		if i := slices.IndexFunc(allocatableVMs, func(vm *armcompute.DedicatedHostAllocatableVM) bool {
+			return vm != nil && vm.VMSize != nil && strings.EqualFold(*vm.VMSize, machineType)
+		}); i >= 0 && allocatableVMs[i].Count != nil {
+			capacity += int32(*allocatableVMs[i].Count)
+		}
+	}
+	return capacity
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// vmExtensionProtectedSettingsKey is the key of the protected settings in the secrets referenced by VM extensions.
+const vmExtensionProtectedSettingsKey = "protectedSettings"
+
+// computeExtensions returns the machine class configuration of the VM extensions of a worker pool. The protected settings
+// are read from the copies of the referenced secrets which gardener maintains in the shoot namespace.
+func (w *workerDelegate) computeExtensions(ctx context.Context, extensions []azureapi.VMExtension) ([]map[string]interface{}, error) {
+	res := make([]map[string]interface{}, 0, len(extensions))
+	for _, extension := range extensions {
+		ext := map[string]interface{}{
+			"name":                    extension.Name,
+			"publisher":               extension.Publisher,
+			"type":                    extension.Type,
+			"typeHandlerVersion":      extension.TypeHandlerVersion,
+			"autoUpgradeMinorVersion": pointer.BoolDeref(extension.AutoUpgradeMinorVersion, true),
+		}
+
+		if extension.Settings != nil {
+			var settings map[string]interface{}
+			if err := json.Unmarshal(extension.Settings.Raw, &settings); err != nil {
+				return nil, fmt.Errorf("failed to decode settings of VM extension %q: %w", extension.Name, err)
+			}
+			ext["settings"] = settings
+		}
+
+		if extension.ProtectedSettingsSecretRef != nil {
+			protectedSettings, err := w.getVMExtensionProtectedSettings(ctx, *extension.ProtectedSettingsSecretRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read protected settings of VM extension %q: %w", extension.Name, err)
+			}
+			ext["protectedSettings"] = protectedSettings
+		}
+
+		res = append(res, ext)
+	}
+	return res, nil
+}
+
+func (w *workerDelegate) getVMExtensionProtectedSettings(ctx context.Context, resourceName string) (string, error) {
+	var ref *autoscalingv1.CrossVersionObjectReference
+	if w.cluster != nil && w.cluster.Shoot != nil {
+		for _, resource := range w.cluster.Shoot.Spec.Resources {
+			if resource.Name == resourceName {
+				ref = resource.ResourceRef.DeepCopy()
+				break
+			}
+		}
+	}
+	if ref == nil {
+		return "", fmt.Errorf("resource %q not found in shoot", resourceName)
+	}
+
+	secret := &corev1.Secret{}
+	if err := extensionscontroller.GetObjectByReference(ctx, w.client, ref, w.worker.Namespace, secret); err != nil {
+		return "", fmt.Errorf("failed to get referenced secret %q: %w", ref.Name, err)
+	}
+	protectedSettings, ok := secret.Data[vmExtensionProtectedSettingsKey]
+	if !ok {
+		return "", fmt.Errorf("referenced secret %q does not contain key %q", ref.Name, vmExtensionProtectedSettingsKey)
+	}
+	return string(protectedSettings), nil
+}
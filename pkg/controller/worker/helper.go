@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +26,28 @@ func (w *workerDelegate) decodeAzureInfrastructureStatus() (*azureapi.Infrastruc
 	return infrastructureStatus, nil
 }
 
+func (w *workerDelegate) decodeAzureInfrastructureConfig() (*azureapi.InfrastructureConfig, error) {
+	infrastructureConfig := &azureapi.InfrastructureConfig{}
+	if w.cluster == nil || w.cluster.Shoot == nil || w.cluster.Shoot.Spec.Provider.InfrastructureConfig == nil {
+		return infrastructureConfig, nil
+	}
+
+	if _, _, err := w.lenientDecoder.Decode(w.cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw, nil, infrastructureConfig); err != nil {
+		return nil, fmt.Errorf("could not decode the infrastructure config of shoot '%s': %w", kutil.ObjectName(w.cluster.Shoot), err)
+	}
+	return infrastructureConfig, nil
+}
+
+func (w *workerDelegate) decodeWorkerConfig(pool extensionsv1alpha1.WorkerPool) (*azureapi.WorkerConfig, error) {
+	workerConfig := &azureapi.WorkerConfig{}
+	if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
+		if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
+			return nil, fmt.Errorf("could not decode provider config: %+v", err)
+		}
+	}
+	return workerConfig, nil
+}
+
 func (w *workerDelegate) decodeWorkerProviderStatus() (*azureapi.WorkerStatus, error) {
 	workerStatus := &azureapi.WorkerStatus{}
 	if w.worker.Status.ProviderStatus == nil {
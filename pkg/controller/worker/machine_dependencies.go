@@ -6,6 +6,9 @@ package worker
 
 import (
 	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 )
@@ -33,16 +36,51 @@ func (w *workerDelegate) PreReconcileHook(ctx context.Context) error {
 		return err
 	}
 
-	if helper.IsVmoRequired(infrastructureStatus) {
-		vmoDependencies, err := w.reconcileVmoDependencies(ctx, infrastructureStatus, workerProviderStatus)
+	desiredProximityPlacementGroups, err := w.desiredProximityPlacementGroups()
+	if err != nil {
+		return err
+	}
+
+	vmoWorkerPools, err := w.vmoWorkerPools(infrastructureStatus)
+	if err != nil {
+		return err
+	}
+
+	// Machines which are stuck in creation are surfaced in the status, so that their serial log can be retrieved. This is
+	// best-effort only and must not block the reconciliation of the machine dependencies, hence the last known machines
+	// are kept if they cannot be determined.
+	stuckMachinesChanged := false
+	if stuckMachines, err := w.findStuckMachines(ctx, infrastructureStatus.ResourceGroup.Name); err != nil {
+		log.FromContext(ctx).Error(err, "Could not determine the machines which are stuck in creation")
+	} else {
+		stuckMachinesChanged = !slices.Equal(stuckMachines, workerProviderStatus.StuckMachines)
+		workerProviderStatus.StuckMachines = stuckMachines
+	}
+
+	if len(vmoWorkerPools) == 0 && len(desiredProximityPlacementGroups) == 0 {
+		if !stuckMachinesChanged {
+			return nil
+		}
+		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
+	}
+
+	if len(vmoWorkerPools) > 0 {
+		vmoDependencies, err := w.reconcileVmoDependencies(ctx, infrastructureStatus, workerProviderStatus, vmoWorkerPools)
 		workerProviderStatus.VmoDependencies = vmoDependencies
 		if err != nil {
 			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
 		}
-		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 	}
 
-	return nil
+	if len(desiredProximityPlacementGroups) > 0 {
+		proximityPlacementGroups, err := w.reconcileProximityPlacementGroups(ctx, infrastructureStatus, workerProviderStatus, desiredProximityPlacementGroups)
+		workerProviderStatus.ProximityPlacementGroups = proximityPlacementGroups
+		if err != nil {
+			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
+		}
+	}
+
+	return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 }
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
@@ -60,7 +98,7 @@ func (w *workerDelegate) PostDeleteHook(ctx context.Context) error {
 	return w.cleanupMachineDependencies(ctx)
 }
 
-// cleanupMachineDependencies cleans up machine dependencies.
+// cleanupMachineDependencies cleans up machine dependencies, i.e. VMOs and proximity placement groups.
 //
 // TODO(dkistner, kon-angelo): Currently both PostReconcileHook and PostDeleteHook funcs call cleanupMachineDependencies.
 // cleanupMachineDependencies calls cleanupVmoDependencies. cleanupVmoDependencies handles the cases when the Worker is being
@@ -77,14 +115,27 @@ func (w *workerDelegate) cleanupMachineDependencies(ctx context.Context) error {
 		return err
 	}
 
-	if helper.IsVmoRequired(infrastructureStatus) {
+	// The VMOs of worker pools which are migrated away from the availability set of the cluster have to be cleaned up as well.
+	vmoDependenciesExist := helper.IsVmoRequired(infrastructureStatus) || len(workerProviderStatus.VmoDependencies) > 0
+	if !vmoDependenciesExist && len(workerProviderStatus.ProximityPlacementGroups) == 0 {
+		return nil
+	}
+
+	if vmoDependenciesExist {
 		vmoDependencies, err := w.cleanupVmoDependencies(ctx, infrastructureStatus, workerProviderStatus)
 		workerProviderStatus.VmoDependencies = vmoDependencies
 		if err != nil {
 			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
 		}
-		return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 	}
 
-	return nil
+	if len(workerProviderStatus.ProximityPlacementGroups) > 0 {
+		proximityPlacementGroups, err := w.cleanupProximityPlacementGroups(ctx, infrastructureStatus, workerProviderStatus)
+		workerProviderStatus.ProximityPlacementGroups = proximityPlacementGroups
+		if err != nil {
+			return w.updateWorkerProviderStatusWithError(ctx, workerProviderStatus, err)
+		}
+	}
+
+	return w.updateWorkerProviderStatus(ctx, workerProviderStatus)
 }
@@ -17,6 +17,7 @@ import (
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -24,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
@@ -80,6 +82,8 @@ var _ = Describe("MachinesDependencies", func() {
 			// Create a vmo seed client mock and let the factory always return the mocked vmo seed client.
 			vmoClient = vmssmock.NewMockVmss(ctrl)
 			factory.EXPECT().Vmss().AnyTimes().Return(vmoClient, nil)
+			// No machine class enables boot diagnostics, hence no machines are checked.
+			c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineClassList{}), gomock.Any()).AnyTimes()
 
 			faultDomainCount = 3
 			cluster = makeCluster("", "westeurope", nil, nil, faultDomainCount)
@@ -163,6 +167,34 @@ var _ = Describe("MachinesDependencies", func() {
 					"PoolName": Equal(vmoDependency.PoolName),
 				})))
 			})
+			It("should deploy a vmo dependency only for the worker pool which is migrated away from the availability set", func() {
+				cluster.Shoot.Annotations = nil
+				availabilitySetID := "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/availabilitySets/sample-avset"
+				infrastructureStatus = makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", false, nil, &availabilitySetID, nil)
+				pool.ProviderConfig = &runtime.RawExtension{
+					Raw: encode(&v1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							APIVersion: v1alpha1.SchemeGroupVersion.String(),
+							Kind:       "WorkerConfig",
+						},
+						OrchestrationMode: (*v1alpha1.OrchestrationMode)(pointer.String(string(v1alpha1.OrchestrationModeVMSSFlex))),
+					}),
+				}
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool, extensionsv1alpha1.WorkerPool{Name: "not-migrated-pool"})
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectVmoCreateToSucceed(ctx, vmoClient, resourceGroupName, vmoName, vmoID)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				err := workerDelegate.PreReconcileHook(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.VmoDependencies).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"ID":       Equal(vmoID),
+					"Name":     Equal(vmoName),
+					"PoolName": Equal(pool.Name),
+				})))
+			})
 		})
 
 		Context("#PostReconcileHook", func() {
@@ -375,8 +407,246 @@ var _ = Describe("MachinesDependencies", func() {
 			})
 		})
 	})
+
+	Describe("Stuck machines", func() {
+		var (
+			cluster              *extensionscontroller.Cluster
+			infrastructureStatus *azureapi.InfrastructureStatus
+			w                    *extensionsv1alpha1.Worker
+
+			makeMachineClass func(name string, bootDiagnostics bool) machinev1alpha1.MachineClass
+			makeMachine      func(name, class string, phase machinev1alpha1.MachinePhase, age time.Duration) machinev1alpha1.Machine
+		)
+
+		BeforeEach(func() {
+			cluster = makeCluster("", region, nil, nil, 3)
+			infrastructureStatus = makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", true, nil, nil, nil)
+			w = makeWorker(namespace, region, nil, infrastructureStatus)
+
+			makeMachineClass = func(name string, bootDiagnostics bool) machinev1alpha1.MachineClass {
+				providerSpec := `{"properties":{}}`
+				if bootDiagnostics {
+					providerSpec = `{"properties":{"diagnosticsProfile":{"bootDiagnostics":{"enabled":true}}}}`
+				}
+				return machinev1alpha1.MachineClass{
+					ObjectMeta:   metav1.ObjectMeta{Name: name, Namespace: namespace},
+					ProviderSpec: runtime.RawExtension{Raw: []byte(providerSpec)},
+				}
+			}
+			makeMachine = func(name, class string, phase machinev1alpha1.MachinePhase, age time.Duration) machinev1alpha1.Machine {
+				return machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, CreationTimestamp: metav1.NewTime(time.Now().Add(-age))},
+					Spec:       machinev1alpha1.MachineSpec{Class: machinev1alpha1.ClassSpec{Name: class}},
+					Status:     machinev1alpha1.MachineStatus{CurrentStatus: machinev1alpha1.CurrentStatus{Phase: phase}},
+				}
+			}
+		})
+
+		Context("#PreReconcileHook", func() {
+			It("should add the machines with boot diagnostics which are stuck in creation to the status", func() {
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, nil)
+
+				expectMachineClassListToSucceed(ctx, c, namespace, makeMachineClass("with-diagnostics", true), makeMachineClass("without-diagnostics", false))
+				expectMachineListToSucceed(ctx, c, namespace,
+					makeMachine("pending", "with-diagnostics", machinev1alpha1.MachinePending, time.Hour),
+					makeMachine("crashloop", "with-diagnostics", machinev1alpha1.MachineCrashLoopBackOff, time.Hour),
+					makeMachine("pending-recently-created", "with-diagnostics", machinev1alpha1.MachinePending, time.Minute),
+					makeMachine("running", "with-diagnostics", machinev1alpha1.MachineRunning, time.Hour),
+					makeMachine("pending-without-diagnostics", "without-diagnostics", machinev1alpha1.MachinePending, time.Hour),
+				)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.StuckMachines).To(Equal([]v1alpha1.StuckMachine{
+					{Name: "crashloop", ResourceGroup: resourceGroupName, Phase: "CrashLoopBackOff"},
+					{Name: "pending", ResourceGroup: resourceGroupName, Phase: "Pending"},
+				}))
+			})
+
+			It("should remove the machines from the status once they are not stuck anymore", func() {
+				workerStatus := &v1alpha1.WorkerStatus{
+					TypeMeta:      metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+					StuckMachines: []v1alpha1.StuckMachine{{Name: "pending", ResourceGroup: resourceGroupName, Phase: "Pending"}},
+				}
+				w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(workerStatus)}
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, nil)
+
+				expectMachineClassListToSucceed(ctx, c, namespace, makeMachineClass("with-diagnostics", true))
+				expectMachineListToSucceed(ctx, c, namespace, makeMachine("pending", "with-diagnostics", machinev1alpha1.MachineRunning, time.Hour))
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				Expect(decodeWorkerProviderStatus(w).StuckMachines).To(BeEmpty())
+			})
+
+			It("should keep the last known machines if the stuck machines cannot be determined", func() {
+				w.Status.ProviderStatus = &runtime.RawExtension{Raw: encode(&v1alpha1.WorkerStatus{
+					TypeMeta:      metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "WorkerStatus"},
+					StuckMachines: []v1alpha1.StuckMachine{{Name: "pending", ResourceGroup: resourceGroupName, Phase: "Pending"}},
+				})}
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, nil)
+
+				expectMachineClassListToSucceed(ctx, c, namespace, makeMachineClass("with-diagnostics", true))
+				c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).Return(fmt.Errorf("fake error"))
+				// the status is not updated, as the stuck machines did not change.
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("Proximity Placement Groups", func() {
+		var (
+			ppgClient *factorymock.MockProximityPlacementGroup
+
+			cluster              *extensionscontroller.Cluster
+			infrastructureStatus *azureapi.InfrastructureStatus
+			pool                 extensionsv1alpha1.WorkerPool
+			zone1Group           v1alpha1.ProximityPlacementGroup
+		)
+
+		BeforeEach(func() {
+			ppgClient = factorymock.NewMockProximityPlacementGroup(ctrl)
+			factory.EXPECT().ProximityPlacementGroup().AnyTimes().Return(ppgClient, nil)
+			// No machine class enables boot diagnostics, hence no machines are checked.
+			c.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&machinev1alpha1.MachineClassList{}), gomock.Any()).AnyTimes()
+
+			cluster = makeCluster("", region, nil, nil, 3)
+			infrastructureStatus = makeInfrastructureStatus(resourceGroupName, "vnet-name", "subnet-name", true, nil, nil, nil)
+			pool = extensionsv1alpha1.WorkerPool{
+				Name:  "my-pool",
+				Zones: []string{"1", "2"},
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"proximityPlacementGroup": {}
+}`)},
+			}
+			zone1Group = v1alpha1.ProximityPlacementGroup{
+				PoolName: pool.Name,
+				Zone:     "1",
+				ID:       "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg-my-pool-z1",
+				Name:     "ppg-my-pool-z1",
+			}
+		})
+
+		Context("#PreReconcileHook", func() {
+			It("should create a proximity placement group for each zone of the worker pool", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectProximityPlacementGroupCreateToSucceed(ctx, ppgClient, resourceGroupName, namespace+"-ppg-my-pool-z1")
+				expectProximityPlacementGroupCreateToSucceed(ctx, ppgClient, resourceGroupName, namespace+"-ppg-my-pool-z2")
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.ProximityPlacementGroups).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{"PoolName": Equal(pool.Name), "Zone": Equal("1"), "Name": Equal(namespace + "-ppg-my-pool-z1"), "ID": HaveSuffix(namespace + "-ppg-my-pool-z1")}),
+					MatchFields(IgnoreExtras, Fields{"PoolName": Equal(pool.Name), "Zone": Equal("2"), "Name": Equal(namespace + "-ppg-my-pool-z2"), "ID": HaveSuffix(namespace + "-ppg-my-pool-z2")}),
+				))
+			})
+
+			It("should not recreate an existing proximity placement group which still uses the name without the shoot prefix", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(zone1Group)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				ppgClient.EXPECT().Get(ctx, resourceGroupName, zone1Group.Name).Return(&armcompute.ProximityPlacementGroup{ID: pointer.String(zone1Group.ID), Name: pointer.String(zone1Group.Name)}, nil)
+				expectProximityPlacementGroupCreateToSucceed(ctx, ppgClient, resourceGroupName, namespace+"-ppg-my-pool-z2")
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.ProximityPlacementGroups).To(HaveLen(2))
+				Expect(workerStatus.ProximityPlacementGroups).To(ContainElement(zone1Group))
+			})
+		})
+
+		Context("#PostReconcileHook", func() {
+			It("should keep the proximity placement groups of existing worker pools", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(zone1Group)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.ProximityPlacementGroups).To(ConsistOf(zone1Group))
+			})
+
+			It("should delete the proximity placement groups which are not used anymore", func() {
+				pool.ProviderConfig = nil
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(zone1Group)
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				ppgClient.EXPECT().Delete(ctx, resourceGroupName, zone1Group.Name).Return(nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.ProximityPlacementGroups).To(BeEmpty())
+			})
+		})
+
+		Context("#PostDeleteHook", func() {
+			It("should delete all proximity placement groups as the Worker is intended to be deleted", func() {
+				w := makeWorker(namespace, region, nil, infrastructureStatus, pool)
+				w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(zone1Group)
+				w.GetObjectMeta().SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+				workerDelegate := wrapNewWorkerDelegate(c, nil, w, cluster, factory)
+
+				ppgClient.EXPECT().Delete(ctx, resourceGroupName, zone1Group.Name).Return(nil)
+				expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+				Expect(workerDelegate.PostDeleteHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerProviderStatus(w)
+				Expect(workerStatus.ProximityPlacementGroups).To(BeEmpty())
+			})
+		})
+	})
 })
 
+func expectMachineClassListToSucceed(ctx context.Context, c *mockclient.MockClient, namespace string, machineClasses ...machinev1alpha1.MachineClass) {
+	c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineClassList{}), client.InNamespace(namespace)).
+		DoAndReturn(func(_ context.Context, list *machinev1alpha1.MachineClassList, _ ...client.ListOption) error {
+			list.Items = machineClasses
+			return nil
+		})
+}
+
+func expectMachineListToSucceed(ctx context.Context, c *mockclient.MockClient, namespace string, machines ...machinev1alpha1.Machine) {
+	c.EXPECT().List(ctx, gomock.AssignableToTypeOf(&machinev1alpha1.MachineList{}), client.InNamespace(namespace)).
+		DoAndReturn(func(_ context.Context, list *machinev1alpha1.MachineList, _ ...client.ListOption) error {
+			list.Items = machines
+			return nil
+		})
+}
+
+func expectProximityPlacementGroupCreateToSucceed(ctx context.Context, c *factorymock.MockProximityPlacementGroup, resourceGroupName, name string) {
+	c.EXPECT().CreateOrUpdate(ctx, resourceGroupName, name, gomock.AssignableToTypeOf(armcompute.ProximityPlacementGroup{})).Return(&armcompute.ProximityPlacementGroup{
+		ID:   pointer.String(fmt.Sprintf("/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/proximityPlacementGroups/%s", name)),
+		Name: pointer.String(name),
+	}, nil)
+}
+
+func generateWorkerStatusWithProximityPlacementGroups(proximityPlacementGroups ...v1alpha1.ProximityPlacementGroup) *runtime.RawExtension {
+	workerStatus := &v1alpha1.WorkerStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "WorkerStatus",
+		},
+		ProximityPlacementGroups: proximityPlacementGroups,
+	}
+	workerStatusMarshaled, err := json.Marshal(workerStatus)
+	Expect(err).NotTo(HaveOccurred())
+	return &runtime.RawExtension{
+		Raw: workerStatusMarshaled,
+	}
+}
+
 func expectVmoGetToSucceed(ctx context.Context, c *vmssmock.MockVmss, resourceGroupName, name, id string, faultDomainCount int32) {
 	// As the vmo name (parameter 3) contains a random suffix, we use simply anything of type string for the mock.
 	c.EXPECT().Get(ctx, resourceGroupName, gomock.AssignableToTypeOf(""), to.Ptr(armcompute.ExpandTypesForGetVMScaleSetsUserData)).Return(&armcompute.VirtualMachineScaleSet{
@@ -6,9 +6,11 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -19,12 +21,15 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-azure/charts"
 	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
 	azureapihelper "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/azure"
 )
 
 const azureCSIDiskDriverTopologyKey = "topology.disk.csi.azure.com/zone"
@@ -84,6 +89,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments        = worker.MachineDeployments{}
 		machineClasses            []map[string]interface{}
 		machineImages             []azureapi.MachineImage
+		windowsAdminCredentials   *windowsAdminCredentials
 	)
 
 	infrastructureStatus, err := w.decodeAzureInfrastructureStatus()
@@ -91,6 +97,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
+	infrastructureConfig, err := w.decodeAzureInfrastructureConfig()
+	if err != nil {
+		return err
+	}
+
 	workerStatus, err := w.decodeWorkerProviderStatus()
 	if err != nil {
 		return err
@@ -102,50 +113,86 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 	}
 
 	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return err
+		}
+
 		// Get the vmo dependency from the worker status if exists.
-		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool.Name)
+		vmoDependency, err := w.determineWorkerPoolVmoDependency(ctx, infrastructureStatus, workerStatus, pool.Name, workerConfig)
 		if err != nil {
 			return err
 		}
 
 		arch := pointer.StringDeref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
 
-		urn, id, communityGalleryImageID, sharedGalleryImageID, imageSupportAcceleratedNetworking, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, &arch)
+		machineImage, err := w.findMachineImage(pool.MachineImage.Name, pool.MachineImage.Version, &arch)
 		if err != nil {
 			return err
 		}
 		machineImages = appendMachineImage(machineImages, azureapi.MachineImage{
 			Name:                    pool.MachineImage.Name,
 			Version:                 pool.MachineImage.Version,
-			URN:                     urn,
-			ID:                      id,
-			CommunityGalleryImageID: communityGalleryImageID,
-			SharedGalleryImageID:    sharedGalleryImageID,
-			AcceleratedNetworking:   imageSupportAcceleratedNetworking,
+			URN:                     machineImage.URN,
+			ID:                      machineImage.ID,
+			CommunityGalleryImageID: machineImage.CommunityGalleryImageID,
+			SharedGalleryImageID:    machineImage.SharedGalleryImageID,
+			AcceleratedNetworking:   machineImage.AcceleratedNetworking,
 			Architecture:            &arch,
+			Plan:                    machineImage.Plan,
+			OperatingSystem:         machineImage.OperatingSystem,
 		})
+		windows := azureapihelper.IsWindowsMachineImage(machineImage)
+		if windows && windowsAdminCredentials == nil {
+			if windowsAdminCredentials, err = w.getWindowsAdminCredentials(ctx); err != nil {
+				return err
+			}
+		}
 
 		image := map[string]interface{}{}
-		if urn != nil {
-			image["urn"] = *urn
-		} else if communityGalleryImageID != nil {
-			image["communityGalleryImageID"] = *communityGalleryImageID
-		} else if sharedGalleryImageID != nil {
-			image["sharedGalleryImageID"] = *sharedGalleryImageID
+		if machineImage.URN != nil {
+			image["urn"] = *machineImage.URN
+			if machineImage.Plan != nil {
+				image["plan"] = map[string]interface{}{
+					"name":      machineImage.Plan.Name,
+					"product":   machineImage.Plan.Product,
+					"publisher": machineImage.Plan.Publisher,
+				}
+			}
+		} else if machineImage.CommunityGalleryImageID != nil {
+			image["communityGalleryImageID"] = *machineImage.CommunityGalleryImageID
+		} else if machineImage.SharedGalleryImageID != nil {
+			image["sharedGalleryImageID"] = *machineImage.SharedGalleryImageID
 		} else {
-			image["id"] = *id
+			image["id"] = *machineImage.ID
 		}
 
-		disks, err := computeDisks(pool)
+		diskEncryption := mergeDiskEncryption(infrastructureConfig.DiskEncryption, workerConfig.DiskEncryption)
+		bootDiagnostics := mergeBootDiagnostics(infrastructureConfig.BootDiagnostics, workerConfig.BootDiagnostics)
+
+		disks, err := computeDisks(pool, workerConfig, diskEncryption)
 		if err != nil {
 			return err
 		}
 
-		workerConfig := &azureapi.WorkerConfig{}
-		if pool.ProviderConfig != nil && pool.ProviderConfig.Raw != nil {
-			if _, _, err := w.decoder.Decode(pool.ProviderConfig.Raw, nil, workerConfig); err != nil {
-				return fmt.Errorf("could not decode provider config: %+v", err)
-			}
+		applicationSecurityGroupIDs, err := findApplicationSecurityGroupIDs(workerConfig.ApplicationSecurityGroups, infrastructureStatus)
+		if err != nil {
+			return fmt.Errorf("failed to determine application security groups of worker pool %q: %w", pool.Name, err)
+		}
+
+		networkInterfaceSubnetNames, err := findNetworkInterfaceSubnetNames(workerConfig.AdditionalNetworkInterfaces, infrastructureStatus)
+		if err != nil {
+			return fmt.Errorf("failed to determine network interfaces of worker pool %q: %w", pool.Name, err)
+		}
+
+		nodeTemplateCapacity, err := w.computeNodeTemplateCapacity(pool)
+		if err != nil {
+			return fmt.Errorf("failed to compute node template of worker pool %q: %w", pool.Name, err)
+		}
+
+		extensions, err := w.computeExtensions(ctx, workerConfig.Extensions)
+		if err != nil {
+			return fmt.Errorf("failed to compute VM extensions of worker pool %q: %w", pool.Name, err)
 		}
 
 		generateMachineClassAndDeployment := func(zone *zoneInfo, machineSet *machineSetInfo, subnetName, workerPoolHash string, workerConfig *azureapi.WorkerConfig) (worker.MachineDeployment, map[string]interface{}) {
@@ -155,9 +202,9 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					Maximum:              pool.Maximum,
 					MaxSurge:             pool.MaxSurge,
 					MaxUnavailable:       pool.MaxUnavailable,
-					Labels:               addTopologyLabel(pool.Labels, w.worker.Spec.Region, zone),
+					Labels:               addOperatingSystemLabel(addSpotLabel(addTopologyLabel(pool.Labels, w.worker.Spec.Region, zone), workerConfig.Spot), windows),
 					Annotations:          pool.Annotations,
-					Taints:               pool.Taints,
+					Taints:               addOperatingSystemTaint(addSpotTaint(pool.Taints, workerConfig.Spot), windows),
 					MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
 				}
 
@@ -172,12 +219,22 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 						"name":      w.worker.Spec.SecretRef.Name,
 						"namespace": w.worker.Spec.SecretRef.Namespace,
 					},
-					"machineType":  pool.MachineType,
-					"image":        image,
-					"sshPublicKey": string(w.worker.Spec.SSHPublicKey),
+					"machineType": pool.MachineType,
+					"image":       image,
 				}, disks)
 			)
 
+			// The user data of Windows machines is passed as custom data as well, but they are accessed with the
+			// credentials of the local administrator instead of SSH keys.
+			if windows {
+				machineClassSpec["windows"] = map[string]interface{}{
+					"adminUsername": windowsAdminCredentials.username,
+					"adminPassword": windowsAdminCredentials.password,
+				}
+			} else {
+				machineClassSpec["sshPublicKey"] = string(w.worker.Spec.SSHPublicKey)
+			}
+
 			networkConfig := map[string]interface{}{
 				"vnet":   infrastructureStatus.Networks.VNet.Name,
 				"subnet": subnetName,
@@ -185,9 +242,19 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			if infrastructureStatus.Networks.VNet.ResourceGroup != nil {
 				networkConfig["vnetResourceGroup"] = *infrastructureStatus.Networks.VNet.ResourceGroup
 			}
-			if imageSupportAcceleratedNetworking != nil && *imageSupportAcceleratedNetworking && w.isMachineTypeSupportingAcceleratedNetworking(pool.MachineType) && acceleratedNetworkAllowed {
+			acceleratedNetworking := pointer.BoolDeref(machineImage.AcceleratedNetworking, false) && w.isMachineTypeSupportingAcceleratedNetworking(pool.MachineType) && acceleratedNetworkAllowed
+			if acceleratedNetworking {
 				networkConfig["acceleratedNetworking"] = true
 			}
+			if len(applicationSecurityGroupIDs) > 0 {
+				networkConfig["applicationSecurityGroupIDs"] = applicationSecurityGroupIDs
+			}
+			if count := pointer.Int32Deref(workerConfig.SecondaryIPConfigurations, 0); count > 0 {
+				networkConfig["secondaryIPConfigurations"] = count
+			}
+			if len(workerConfig.AdditionalNetworkInterfaces) > 0 {
+				networkConfig["additionalNetworkInterfaces"] = computeAdditionalNetworkInterfaces(workerConfig.AdditionalNetworkInterfaces, networkInterfaceSubnetNames, subnetName, acceleratedNetworking)
+			}
 			machineClassSpec["network"] = networkConfig
 
 			if zone != nil {
@@ -198,7 +265,20 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				machineClassSpec["zone"] = zone.name
 			}
 
-			if pool.NodeTemplate != nil {
+			// The node template of the worker config takes precedence. Otherwise, the node template is derived from the
+			// capabilities of the machine type in the cloud profile, which are more precise than the node template of the
+			// worker pool.
+			var capacity corev1.ResourceList
+			switch {
+			case workerConfig.NodeTemplate != nil:
+				capacity = workerConfig.NodeTemplate.Capacity
+			case nodeTemplateCapacity != nil:
+				capacity = nodeTemplateCapacity
+			case pool.NodeTemplate != nil:
+				capacity = pool.NodeTemplate.Capacity
+			}
+
+			if capacity != nil {
 				//	Currently Zone field is mandatory, and passing it an
 				//	empty string turns it to `null` string during marshalling which fails CRD validation
 				//	so setting it to a dummy value `no-zone`
@@ -209,20 +289,11 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 					zoneName = w.worker.Spec.Region + "-" + zone.name
 				}
 
-				if workerConfig.NodeTemplate != nil {
-					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-						Capacity:     workerConfig.NodeTemplate.Capacity,
-						InstanceType: pool.MachineType,
-						Region:       w.worker.Spec.Region,
-						Zone:         zoneName,
-					}
-				} else if pool.NodeTemplate != nil {
-					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-						Capacity:     pool.NodeTemplate.Capacity,
-						InstanceType: pool.MachineType,
-						Region:       w.worker.Spec.Region,
-						Zone:         zoneName,
-					}
+				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
+					Capacity:     capacity,
+					InstanceType: pool.MachineType,
+					Region:       w.worker.Spec.Region,
+					Zone:         zoneName,
 				}
 			}
 
@@ -237,6 +308,26 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				machineClassSpec["identityID"] = infrastructureStatus.Identity.ID
 			}
 
+			if diskEncryption != nil && pointer.BoolDeref(diskEncryption.EncryptionAtHost, false) {
+				machineClassSpec["encryptionAtHost"] = true
+			}
+
+			if workerConfig.Spot != nil {
+				machineClassSpec["spot"] = computeSpot(workerConfig.Spot)
+			}
+
+			if workerConfig.Security != nil {
+				machineClassSpec["security"] = computeSecurity(workerConfig.Security)
+			}
+
+			if len(extensions) > 0 {
+				machineClassSpec["extensions"] = extensions
+			}
+
+			if bootDiagnostics != nil && bootDiagnostics.Enabled {
+				machineClassSpec["bootDiagnostics"] = computeBootDiagnostics(bootDiagnostics)
+			}
+
 			var (
 				deploymentName = fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
 				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
@@ -257,29 +348,88 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return machineDeployment, machineClassSpec
 		}
 
-		workerPoolHash, err := w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, nil)
+		// Pools which are placed in an additional subnet use it in all zones, as subnets span all zones of the region.
+		var (
+			subnetName           = nodesSubnet.Name
+			additionalSubnetName *string
+		)
+		if workerConfig.SubnetName != nil {
+			additionalSubnet, err := azureapihelper.FindAdditionalSubnetByName(infrastructureStatus.Networks.Subnets, *workerConfig.SubnetName)
+			if err != nil {
+				return fmt.Errorf("failed to find subnet of worker pool %q: %w", pool.Name, err)
+			}
+			subnetName = additionalSubnet.Name
+			additionalSubnetName = &additionalSubnet.Name
+		}
+
+		workerPoolHash, err := w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, workerConfig, diskEncryption, applicationSecurityGroupIDs, additionalSubnetName)
 		if err != nil {
 			return err
 		}
 
+		if workerConfig.ProximityPlacementGroup != nil && !infrastructureStatus.Zoned {
+			return fmt.Errorf("worker pool %q cannot use proximity placement groups because the cluster is not zoned", pool.Name)
+		}
+
+		var dedicatedHost *dedicatedHostPlacement
+		if workerConfig.DedicatedHost != nil {
+			if !infrastructureStatus.Zoned {
+				return fmt.Errorf("worker pool %q cannot use dedicated hosts because the cluster is not zoned", pool.Name)
+			}
+			if dedicatedHost, err = w.getDedicatedHostPlacement(ctx, workerConfig.DedicatedHost); err != nil {
+				return fmt.Errorf("failed to get dedicated hosts of worker pool %q: %w", pool.Name, err)
+			}
+		}
+
+		var capacityReservation *capacityReservationPlacement
+		if workerConfig.CapacityReservation != nil {
+			if !infrastructureStatus.Zoned && vmoDependency == nil {
+				return fmt.Errorf("worker pool %q cannot use capacity reservations because the cluster uses an availability set", pool.Name)
+			}
+			if capacityReservation, err = w.getCapacityReservationPlacement(ctx, workerConfig.CapacityReservation); err != nil {
+				return fmt.Errorf("failed to get capacity reservations of worker pool %q: %w", pool.Name, err)
+			}
+		}
+
 		// VMO
 		if vmoDependency != nil {
 			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(nil, &machineSetInfo{
 				id:   vmoDependency.ID,
 				kind: "vmo",
-			}, nodesSubnet.Name, workerPoolHash, workerConfig)
+			}, subnetName, workerPoolHash, workerConfig)
+			if capacityReservation != nil {
+				if err := capacityReservation.validate(pool.MachineType, ""); err != nil {
+					return err
+				}
+				machineClassSpec["capacityReservationGroupID"] = workerConfig.CapacityReservation.CapacityReservationGroupID
+			}
 			machineDeployments = append(machineDeployments, machineDeployment)
 			machineClasses = append(machineClasses, machineClassSpec)
 			continue
 		}
 
 		// AvailabilitySet
-		if !infrastructureStatus.Zoned && vmoDependency == nil {
+		// While a non-zonal cluster is migrated to a zonal cluster, the worker pools without zones remain in the
+		// availability set until they are moved to zones.
+		if (!infrastructureStatus.Zoned || len(pool.Zones) == 0) && vmoDependency == nil {
 			nodesAvailabilitySet, err := azureapihelper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, azureapi.PurposeNodes)
 			if err != nil {
 				return err
 			}
 
+			if workerConfig.Spot != nil {
+				return fmt.Errorf("worker pool %q cannot use spot machines because the cluster uses an availability set", pool.Name)
+			}
+
+			// The machines of the availability set remain in the subnet which was taken over by one of the zones.
+			if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet && workerConfig.SubnetName == nil {
+				for _, subnet := range infrastructureStatus.Networks.Subnets {
+					if subnet.Purpose == azureapi.PurposeNodes && subnet.Migrated {
+						subnetName = subnet.Name
+					}
+				}
+			}
+
 			// Do not enable accelerated networking for AvSet cluster.
 			// This is necessary to avoid `ExistingAvailabilitySetWasNotDeployedOnAcceleratedNetworkingEnabledCluster` error.
 			acceleratedNetworkAllowed = false
@@ -287,7 +437,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(nil, &machineSetInfo{
 				id:   nodesAvailabilitySet.ID,
 				kind: "availabilityset",
-			}, nodesSubnet.Name, workerPoolHash, workerConfig)
+			}, subnetName, workerPoolHash, workerConfig)
 			machineDeployments = append(machineDeployments, machineDeployment)
 			machineClasses = append(machineClasses, machineClassSpec)
 			continue
@@ -296,29 +446,59 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		// Availability Zones
 		zoneCount := len(pool.Zones)
 		for zoneIndex, zone := range pool.Zones {
-			if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet {
+			if infrastructureStatus.Networks.Layout == azureapi.NetworkLayoutMultipleSubnet && workerConfig.SubnetName == nil {
 				_, nodesSubnet, err = azureapihelper.FindSubnetByPurposeAndZone(infrastructureStatus.Networks.Subnets, azureapi.PurposeNodes, &zone)
 				if err != nil {
 					return err
 				}
 
 				if nodesSubnet.Migrated {
-					workerPoolHash, err = w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, nil)
+					workerPoolHash, err = w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, workerConfig, diskEncryption, applicationSecurityGroupIDs, nil)
 					if err != nil {
 						return err
 					}
 				} else {
-					workerPoolHash, err = w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, &nodesSubnet.Name)
+					workerPoolHash, err = w.generateWorkerPoolHash(pool, infrastructureStatus, vmoDependency, workerConfig, diskEncryption, applicationSecurityGroupIDs, &nodesSubnet.Name)
 					if err != nil {
 						return err
 					}
 				}
+				subnetName = nodesSubnet.Name
 			}
 			machineDeployment, machineClassSpec := generateMachineClassAndDeployment(&zoneInfo{
 				name:  zone,
 				index: int32(zoneIndex),
 				count: int32(zoneCount),
-			}, nil, nodesSubnet.Name, workerPoolHash, workerConfig)
+			}, nil, subnetName, workerPoolHash, workerConfig)
+
+			if workerConfig.ProximityPlacementGroup != nil {
+				proximityPlacementGroup := findProximityPlacementGroup(workerStatus.ProximityPlacementGroups, pool.Name, zone)
+				if proximityPlacementGroup == nil {
+					return fmt.Errorf("proximity placement group for worker pool %q in zone %q not found in the worker provider status", pool.Name, zone)
+				}
+				machineClassSpec["proximityPlacementGroupID"] = proximityPlacementGroup.ID
+			}
+
+			if dedicatedHost != nil {
+				if err := dedicatedHost.validateZone(zone); err != nil {
+					return err
+				}
+				machineClassSpec["dedicatedHostGroupID"] = workerConfig.DedicatedHost.HostGroupID
+				if workerConfig.DedicatedHost.HostID != nil {
+					machineClassSpec["dedicatedHostID"] = *workerConfig.DedicatedHost.HostID
+				}
+				// The autoscaler must not scale beyond the capacity of the hosts, but the minimum is kept so that
+				// exhausted hosts are reported.
+				machineDeployment.Maximum = min(machineDeployment.Maximum, max(machineDeployment.Minimum, dedicatedHost.capacity(pool.MachineType, machineDeployment.Name)))
+			}
+
+			if capacityReservation != nil {
+				if err := capacityReservation.validate(pool.MachineType, zone); err != nil {
+					return err
+				}
+				machineClassSpec["capacityReservationGroupID"] = workerConfig.CapacityReservation.CapacityReservationGroupID
+			}
+
 			machineDeployments = append(machineDeployments, machineDeployment)
 			machineClasses = append(machineClasses, machineClassSpec)
 		}
@@ -354,7 +534,32 @@ func (w *workerDelegate) getVMTags(pool extensionsv1alpha1.WorkerPool) map[strin
 	return vmTags
 }
 
-func computeDisks(pool extensionsv1alpha1.WorkerPool) (map[string]interface{}, error) {
+// mergeDiskEncryption returns the disk encryption settings of a worker pool. Fields which are not set in the worker
+// config fall back to the defaults of the infrastructure config.
+func mergeDiskEncryption(defaults, diskEncryption *azureapi.DiskEncryption) *azureapi.DiskEncryption {
+	if defaults == nil {
+		return diskEncryption
+	}
+	if diskEncryption == nil {
+		return defaults
+	}
+
+	merged := diskEncryption.DeepCopy()
+	if merged.DiskEncryptionSetID == nil {
+		merged.DiskEncryptionSetID = defaults.DiskEncryptionSetID
+	}
+	if merged.EncryptionAtHost == nil {
+		merged.EncryptionAtHost = defaults.EncryptionAtHost
+	}
+	return merged
+}
+
+func computeDisks(pool extensionsv1alpha1.WorkerPool, workerConfig *azureapi.WorkerConfig, diskEncryption *azureapi.DiskEncryption) (map[string]interface{}, error) {
+	var diskEncryptionSetID *string
+	if diskEncryption != nil {
+		diskEncryptionSetID = diskEncryption.DiskEncryptionSetID
+	}
+
 	// handle root disk
 	volumeSize, err := worker.DiskSize(pool.Volume.Size)
 	if err != nil {
@@ -370,8 +575,21 @@ func computeDisks(pool extensionsv1alpha1.WorkerPool) (map[string]interface{}, e
 	// which was not applied. To do not damage existing cluster we will set for
 	// now the volume type only if it's a valid Azure volume type.
 	// Otherwise we will still use the default volume of the machine type.
-	if pool.Volume.Type != nil && (*pool.Volume.Type == "Standard_LRS" || *pool.Volume.Type == "StandardSSD_LRS" || *pool.Volume.Type == "Premium_LRS") {
-		osDisk["type"] = *pool.Volume.Type
+	if osDiskConfig := workerConfig.OSDisk; osDiskConfig != nil && osDiskConfig.EphemeralPlacement != nil {
+		// Ephemeral OS disks are stored on the local disks of the machine, hence there is no managed disk whose type or
+		// encryption could be configured. Azure requires read-only caching for them.
+		osDisk["ephemeralPlacement"] = string(*osDiskConfig.EphemeralPlacement)
+		osDisk["caching"] = string(azureapi.CachingTypeReadOnly)
+	} else {
+		if pool.Volume.Type != nil && azureapihelper.IsOSDiskType(*pool.Volume.Type) {
+			osDisk["type"] = *pool.Volume.Type
+		}
+		if diskEncryptionSetID != nil {
+			osDisk["diskEncryptionSetID"] = *diskEncryptionSetID
+		}
+		if security := workerConfig.Security; security != nil && security.OSDiskEncryptionType != nil {
+			osDisk["securityEncryptionType"] = string(*security.OSDiskEncryptionType)
+		}
 	}
 
 	disks := map[string]interface{}{
@@ -379,7 +597,10 @@ func computeDisks(pool extensionsv1alpha1.WorkerPool) (map[string]interface{}, e
 	}
 
 	// handle data disks
-	var dataDisks []map[string]interface{}
+	var (
+		dataDisks       []map[string]interface{}
+		ultraSSDEnabled bool
+	)
 	if dataVolumes := pool.DataVolumes; len(dataVolumes) > 0 {
 		// sort data volumes for consistent device naming
 		sort.Slice(dataVolumes, func(i, j int) bool {
@@ -395,26 +616,174 @@ func computeDisks(pool extensionsv1alpha1.WorkerPool) (map[string]interface{}, e
 				"name":       volume.Name,
 				"lun":        int32(i),
 				"diskSizeGB": volumeSize,
-				"caching":    "None",
+				"caching":    string(azureapi.CachingTypeNone),
 			}
 			if volume.Type != nil {
 				disk["storageAccountType"] = *volume.Type
+				if *volume.Type == azure.DiskTypeUltraSSDLRS {
+					ultraSSDEnabled = true
+				}
+			}
+			if diskEncryptionSetID != nil {
+				disk["diskEncryptionSetID"] = *diskEncryptionSetID
+			}
+			if dataVolume := findDataVolumeConfig(workerConfig, volume.Name); dataVolume != nil {
+				if dataVolume.Caching != nil {
+					disk["caching"] = string(*dataVolume.Caching)
+				}
+				if dataVolume.IOPS != nil {
+					disk["diskIOPSReadWrite"] = *dataVolume.IOPS
+				}
+				if dataVolume.ThroughputMBps != nil {
+					disk["diskMBpsReadWrite"] = *dataVolume.ThroughputMBps
+				}
 			}
 			dataDisks = append(dataDisks, disk)
 		}
 
 		disks["dataDisks"] = dataDisks
+		// Ultra disks can only be attached to machines which have the ultra SSD capability enabled.
+		if ultraSSDEnabled {
+			disks["ultraSSDEnabled"] = true
+		}
 	}
 
 	return disks, nil
 }
 
+// findApplicationSecurityGroupIDs returns the ids of the referenced application security groups. Groups referenced by
+// name are created as part of the infrastructure and looked up in its status.
+func findApplicationSecurityGroupIDs(refs []azureapi.ApplicationSecurityGroupReference, infrastructureStatus *azureapi.InfrastructureStatus) ([]string, error) {
+	var ids []string
+	for _, ref := range refs {
+		if ref.ID != nil {
+			ids = append(ids, *ref.ID)
+			continue
+		}
+
+		i := slices.IndexFunc(infrastructureStatus.ApplicationSecurityGroups, func(asg azureapi.ApplicationSecurityGroup) bool {
+			return asg.Name == pointer.StringDeref(ref.Name, "")
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("application security group %q not found in infrastructure status", pointer.StringDeref(ref.Name, ""))
+		}
+		ids = append(ids, infrastructureStatus.ApplicationSecurityGroups[i].ID)
+	}
+	return ids, nil
+}
+
+// findNetworkInterfaceSubnetNames returns the names of the subnets the additional network interfaces are placed in. The
+// name is nil for network interfaces which are placed in the subnet of the primary network interface.
+func findNetworkInterfaceSubnetNames(nics []azureapi.NetworkInterface, infrastructureStatus *azureapi.InfrastructureStatus) ([]*string, error) {
+	names := make([]*string, 0, len(nics))
+	for _, nic := range nics {
+		if nic.SubnetName == nil {
+			names = append(names, nil)
+			continue
+		}
+		subnet, err := azureapihelper.FindAdditionalSubnetByName(infrastructureStatus.Networks.Subnets, *nic.SubnetName)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, &subnet.Name)
+	}
+	return names, nil
+}
+
+// computeAdditionalNetworkInterfaces returns the machine class configuration of the additional network interfaces.
+// Accelerated networking can only be used if it is used for the primary network interface as well.
+func computeAdditionalNetworkInterfaces(nics []azureapi.NetworkInterface, subnetNames []*string, primarySubnetName string, acceleratedNetworking bool) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(nics))
+	for i, nic := range nics {
+		networkInterface := map[string]interface{}{
+			"subnet": pointer.StringDeref(subnetNames[i], primarySubnetName),
+		}
+		if acceleratedNetworking && pointer.BoolDeref(nic.AcceleratedNetworking, true) {
+			networkInterface["acceleratedNetworking"] = true
+		}
+		if count := pointer.Int32Deref(nic.SecondaryIPConfigurations, 0); count > 0 {
+			networkInterface["secondaryIPConfigurations"] = count
+		}
+		res = append(res, networkInterface)
+	}
+	return res
+}
+
+func computeSecurity(security *azureapi.SecurityConfig) map[string]interface{} {
+	return map[string]interface{}{
+		"type":              string(azureapihelper.SecurityType(security)),
+		"secureBootEnabled": pointer.BoolDeref(security.SecureBoot, true),
+		"vTpmEnabled":       pointer.BoolDeref(security.VTPM, true),
+	}
+}
+
+func findDataVolumeConfig(workerConfig *azureapi.WorkerConfig, name string) *azureapi.DataVolume {
+	for i := range workerConfig.DataVolumes {
+		if workerConfig.DataVolumes[i].Name == name {
+			return &workerConfig.DataVolumes[i]
+		}
+	}
+	return nil
+}
+
 // SanitizeAzureVMTag will sanitize the tag base on the azure tag Restrictions
 // refer: https://docs.microsoft.com/en-us/azure/azure-resource-manager/management/tag-resources#limitations
 func SanitizeAzureVMTag(label string) string {
 	return tagRegex.ReplaceAllString(strings.ToLower(label), "_")
 }
 
+func computeSpot(spot *azureapi.SpotConfig) map[string]interface{} {
+	res := map[string]interface{}{
+		"priority":       string(spotPriority(spot)),
+		"evictionPolicy": string(spotEvictionPolicy(spot)),
+	}
+	if spot.MaxPrice != nil {
+		res["maxPrice"] = *spot.MaxPrice
+	}
+	return res
+}
+
+func spotPriority(spot *azureapi.SpotConfig) azureapi.VMPriority {
+	if spot.Priority == nil {
+		return azureapi.VMPrioritySpot
+	}
+	return *spot.Priority
+}
+
+func spotEvictionPolicy(spot *azureapi.SpotConfig) azureapi.SpotEvictionPolicy {
+	if spot.EvictionPolicy == nil {
+		return azureapi.SpotEvictionPolicyDelete
+	}
+	return *spot.EvictionPolicy
+}
+
+// addSpotLabel adds the spot label to the node labels, which lets workloads select spot nodes. As the labels are also
+// part of the node template of the machine deployment, the cluster-autoscaler knows that it scales spot capacity.
+func addSpotLabel(labels map[string]string, spot *azureapi.SpotConfig) map[string]string {
+	if spot == nil {
+		return labels
+	}
+	return utils.MergeStringMaps(labels, map[string]string{azure.ScaleSetPriorityLabel: azure.ScaleSetPrioritySpot})
+}
+
+// addSpotTaint adds the spot taint to the node taints unless the pool already defines a taint with the same key, so that
+// only workloads which tolerate evictions are scheduled on spot nodes.
+func addSpotTaint(taints []corev1.Taint, spot *azureapi.SpotConfig) []corev1.Taint {
+	if spot == nil {
+		return taints
+	}
+	for _, taint := range taints {
+		if taint.Key == azure.ScaleSetPriorityLabel {
+			return taints
+		}
+	}
+	return append(append([]corev1.Taint{}, taints...), corev1.Taint{
+		Key:    azure.ScaleSetPriorityLabel,
+		Value:  azure.ScaleSetPrioritySpot,
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
+
 func addTopologyLabel(labels map[string]string, region string, zone *zoneInfo) map[string]string {
 	if zone != nil {
 		return utils.MergeStringMaps(labels, map[string]string{azureCSIDiskDriverTopologyKey: region + "-" + zone.name})
@@ -422,7 +791,7 @@ func addTopologyLabel(labels map[string]string, region string, zone *zoneInfo) m
 	return labels
 }
 
-func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPool, infrastructureStatus *azureapi.InfrastructureStatus, vmoDependency *azureapi.VmoDependency, subnetName *string) (string, error) {
+func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPool, infrastructureStatus *azureapi.InfrastructureStatus, vmoDependency *azureapi.VmoDependency, workerConfig *azureapi.WorkerConfig, diskEncryption *azureapi.DiskEncryption, applicationSecurityGroupIDs []string, subnetName *string) (string, error) {
 	additionalHashData := []string{}
 
 	// Integrate data disks/volumes in the hash.
@@ -440,6 +809,28 @@ func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 		additionalHashData = append(additionalHashData, infrastructureStatus.Identity.ID)
 	}
 
+	// Switching between managed and ephemeral OS disks requires new machines.
+	if workerConfig.OSDisk != nil && workerConfig.OSDisk.EphemeralPlacement != nil {
+		additionalHashData = append(additionalHashData, "ephemeral", string(*workerConfig.OSDisk.EphemeralPlacement))
+	}
+
+	// The encryption of the disks cannot be changed for existing machines. The settings are only added if they are set
+	// to not roll the machines of pools which do not use them.
+	if diskEncryption != nil {
+		if diskEncryption.DiskEncryptionSetID != nil {
+			additionalHashData = append(additionalHashData, *diskEncryption.DiskEncryptionSetID)
+		}
+		if pointer.BoolDeref(diskEncryption.EncryptionAtHost, false) {
+			additionalHashData = append(additionalHashData, "encryptionAtHost")
+		}
+	}
+
+	// The network interfaces of existing machines are not updated with other application security groups.
+	if len(applicationSecurityGroupIDs) > 0 {
+		additionalHashData = append(additionalHashData, "applicationSecurityGroups")
+		additionalHashData = append(additionalHashData, applicationSecurityGroupIDs...)
+	}
+
 	// Include the vmo dependency name into the workerpool hash.
 	if vmoDependency != nil {
 		additionalHashData = append(additionalHashData, vmoDependency.Name)
@@ -449,6 +840,13 @@ func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 		additionalHashData = append(additionalHashData, *subnetName)
 	}
 
+	// The generic worker pool hash covers the complete provider config, hence the settings which can be changed without
+	// replacing the machines are removed from it.
+	pool, err := withoutNonRollingSettings(pool)
+	if err != nil {
+		return "", err
+	}
+
 	// Generate the worker pool hash.
 	workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, additionalHashData...)
 	if err != nil {
@@ -456,3 +854,72 @@ func (w *workerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 	}
 	return workerPoolHash, nil
 }
+
+// nonRollingDataVolumeSettings are the settings of a data volume in the WorkerConfig which Azure allows to change for
+// attached disks. The disk performance can be adjusted without recreating the machines.
+var nonRollingDataVolumeSettings = []string{"iops", "throughputMBps"}
+
+// nonRollingExtensionSettings are the settings of a VM extension in the WorkerConfig which are only applied to machines
+// created afterwards. Rotating the protected settings, e.g. a key, must not roll the machines.
+var nonRollingExtensionSettings = []string{"autoUpgradeMinorVersion", "settings", "protectedSettingsSecretRef"}
+
+// withoutNonRollingSettings returns a copy of the worker pool whose provider config does not contain the settings which
+// do not require new machines. Of the handler version of a VM extension only the major version is kept, as minor versions
+// are upgraded by Azure. The provider config is only re-encoded if such a setting is present, so that the hash of other
+// worker pools does not change.
+func withoutNonRollingSettings(pool extensionsv1alpha1.WorkerPool) (extensionsv1alpha1.WorkerPool, error) {
+	if pool.ProviderConfig == nil || pool.ProviderConfig.Raw == nil {
+		return pool, nil
+	}
+
+	var providerConfig map[string]interface{}
+	if err := json.Unmarshal(pool.ProviderConfig.Raw, &providerConfig); err != nil {
+		return pool, fmt.Errorf("failed to decode provider config of worker pool %q: %w", pool.Name, err)
+	}
+
+	removed := false
+	for _, dataVolume := range objectsOf(providerConfig["dataVolumes"]) {
+		removed = deleteKeys(dataVolume, nonRollingDataVolumeSettings...) || removed
+	}
+	for _, extension := range objectsOf(providerConfig["extensions"]) {
+		removed = deleteKeys(extension, nonRollingExtensionSettings...) || removed
+		if version, ok := extension["typeHandlerVersion"].(string); ok {
+			if major, _, found := strings.Cut(version, "."); found {
+				extension["typeHandlerVersion"] = major
+				removed = true
+			}
+		}
+	}
+	if !removed {
+		return pool, nil
+	}
+
+	raw, err := json.Marshal(providerConfig)
+	if err != nil {
+		return pool, fmt.Errorf("failed to encode provider config of worker pool %q: %w", pool.Name, err)
+	}
+	pool.ProviderConfig = &runtime.RawExtension{Raw: raw}
+	return pool, nil
+}
+
+func objectsOf(list interface{}) []map[string]interface{} {
+	items, _ := list.([]interface{})
+	res := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if object, ok := item.(map[string]interface{}); ok {
+			res = append(res, object)
+		}
+	}
+	return res
+}
+
+func deleteKeys(object map[string]interface{}, keys ...string) bool {
+	deleted := false
+	for _, key := range keys {
+		if _, ok := object[key]; ok {
+			delete(object, key)
+			deleted = true
+		}
+	}
+	return deleted
+}
@@ -6,34 +6,47 @@ package worker_test
 
 import (
 	"context"
+	"embed"
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/utils"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/gardener-extension-provider-azure/charts"
 	apisazure "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+	"github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/helper"
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure/v1alpha1"
+	factorymock "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client/mock"
 	. "github.com/gardener/gardener-extension-provider-azure/pkg/controller/worker"
 )
 
@@ -692,6 +705,947 @@ var _ = Describe("Machines", func() {
 						Expect(err).NotTo(HaveOccurred())
 						Expect(result).To(Equal(machineDeployments))
 					})
+
+					It("should keep worker pools without zones in the availability set while the cluster is migrated to zones", func() {
+						var values kubernetes.ApplyOptions
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+							Raw: encode(makeInfrastructureStatus(resourceGroupName, vnetName, subnetName, true, &vnetResourceGroupName, &availabilitySetID, &identityID)),
+						}
+						w.Spec.Pools[0].Zones = nil
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								for _, o := range opts {
+									o.MutateApplyOptions(&values)
+								}
+								return nil
+							})
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+						machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(1))
+						Expect(machineClasses[0]["machineSet"]).To(Equal(map[string]interface{}{
+							"id":   availabilitySetID,
+							"kind": "availabilityset",
+						}))
+						Expect(machineClasses[0]).NotTo(HaveKey("zone"))
+					})
+
+					It("should keep worker pools without zones in the subnet which was taken over by a zone while the cluster is migrated to zones", func() {
+						var values kubernetes.ApplyOptions
+						infrastructureStatus := makeInfrastructureStatus(resourceGroupName, vnetName, subnetName, true, &vnetResourceGroupName, &availabilitySetID, &identityID)
+						infrastructureStatus.Networks.Layout = apisazure.NetworkLayoutMultipleSubnet
+						infrastructureStatus.Networks.Subnets = []apisazure.Subnet{
+							{Name: subnetName + "-z1", Purpose: apisazure.PurposeNodes, Zone: pointer.String("1")},
+							{Name: subnetName, Purpose: apisazure.PurposeNodes, Zone: pointer.String("2"), Migrated: true},
+						}
+						w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+						w.Spec.Pools[0].Zones = nil
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								for _, o := range opts {
+									o.MutateApplyOptions(&values)
+								}
+								return nil
+							})
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+						machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+						Expect(machineClasses).To(HaveLen(1))
+						Expect(machineClasses[0]["network"]).To(HaveKeyWithValue("subnet", subnetName))
+					})
+
+					It("should label and taint spot machines and render the spot configuration", func() {
+						var values kubernetes.ApplyOptions
+						w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"spot": {"evictionPolicy": "Deallocate", "maxPrice": "0.05"}
+}`)}
+						workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+						chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								for _, o := range opts {
+									o.MutateApplyOptions(&values)
+								}
+								return nil
+							})
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+						for _, machineClass := range values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{}) {
+							Expect(machineClass["spot"]).To(Equal(map[string]interface{}{
+								"priority":       "Spot",
+								"evictionPolicy": "Deallocate",
+								"maxPrice":       "0.05",
+							}))
+						}
+
+						workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID)
+						Expect(err).NotTo(HaveOccurred())
+
+						result, err := workerDelegate.GenerateMachineDeployments(ctx)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(result).To(HaveLen(2))
+						Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+						for _, machineDeployment := range result {
+							Expect(machineDeployment.Labels).To(HaveKeyWithValue("kubernetes.azure.com/scalesetpriority", "spot"))
+							Expect(machineDeployment.Taints).To(ConsistOf(corev1.Taint{
+								Key:    "kubernetes.azure.com/scalesetpriority",
+								Value:  "spot",
+								Effect: corev1.TaintEffectNoSchedule,
+							}))
+						}
+					})
+
+					Context("node templates", func() {
+						var values kubernetes.ApplyOptions
+
+						nodeTemplateOfMachineClass := func() machinev1alpha1.NodeTemplate {
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							return machineClasses[0]["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+						}
+
+						expectCapacity := func(capacity corev1.ResourceList, expected map[corev1.ResourceName]string) {
+							Expect(capacity).To(HaveLen(len(expected)))
+							for name, quantity := range expected {
+								Expect(capacity).To(HaveKey(name))
+								actual := capacity[name]
+								Expect(actual.Cmp(resource.MustParse(quantity))).To(BeZero(), "unexpected quantity %s of %s", actual.String(), name)
+							}
+						}
+
+						BeforeEach(func() {
+							values = kubernetes.ApplyOptions{}
+							machineTypes[0].CPU = resource.NewQuantity(4, resource.DecimalSI)
+							machineTypes[0].Memory = resource.NewQuantity(16*1024*1024*1024, resource.BinarySI)
+							machineTypes[0].GPU = resource.NewQuantity(1, resource.DecimalSI)
+							cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+							cluster.Shoot.Spec.Kubernetes.Kubelet = &gardencorev1beta1.KubeletConfig{
+								KubeReserved: &gardencorev1beta1.KubeletConfigReserved{
+									CPU:    resource.NewMilliQuantity(100, resource.DecimalSI),
+									Memory: resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+								},
+								EvictionHard: &gardencorev1beta1.KubeletConfigEviction{
+									MemoryAvailable: pointer.String("100Mi"),
+									NodeFSAvailable: pointer.String("10%"),
+								},
+							}
+						})
+
+						It("should derive the node template from the machine type and subtract the reserved resources", func() {
+							nodeTemplate := nodeTemplateOfMachineClass()
+							Expect(nodeTemplate.InstanceType).To(Equal(machineType))
+							Expect(nodeTemplate.Zone).To(Equal(region + "-" + zone1))
+							expectCapacity(nodeTemplate.Capacity, map[corev1.ResourceName]string{
+								"cpu":               "3900m",
+								"memory":            "15260Mi",
+								"gpu":               "1",
+								"ephemeral-storage": "18Gi",
+							})
+						})
+
+						It("should use the kubelet configuration and the kubelet data volume of the worker pool", func() {
+							cluster.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{
+								Name:       namePoolZones,
+								Kubernetes: &gardencorev1beta1.WorkerKubernetes{Kubelet: &gardencorev1beta1.KubeletConfig{}},
+							}}
+							w.Spec.Pools[0].DataVolumes = []extensionsv1alpha1.DataVolume{{Name: "kubelet", Size: "100Gi"}}
+							w.Spec.Pools[0].KubeletDataVolumeName = pointer.String("kubelet")
+
+							// the kubelet defaults apply as the kubelet configuration of the worker pool is empty.
+							expectCapacity(nodeTemplateOfMachineClass().Capacity, map[corev1.ResourceName]string{
+								"cpu":               "3920m",
+								"memory":            "15260Mi",
+								"gpu":               "1",
+								"ephemeral-storage": "95Gi",
+							})
+						})
+
+						It("should subtract the kubelet defaults if the reserved resources are not configured", func() {
+							cluster.Shoot.Spec.Kubernetes.Kubelet = nil
+
+							expectCapacity(nodeTemplateOfMachineClass().Capacity, map[corev1.ResourceName]string{
+								"cpu":               "3920m",
+								"memory":            "15260Mi",
+								"gpu":               "1",
+								"ephemeral-storage": "19Gi",
+							})
+						})
+
+						It("should only apply the kubelet defaults for the reserved resources which are not configured", func() {
+							cluster.Shoot.Spec.Kubernetes.Kubelet = &gardencorev1beta1.KubeletConfig{
+								KubeReserved: &gardencorev1beta1.KubeletConfigReserved{
+									Memory: resource.NewQuantity(2*1024*1024*1024, resource.BinarySI),
+								},
+								SystemReserved: &gardencorev1beta1.KubeletConfigReserved{
+									CPU: resource.NewMilliQuantity(20, resource.DecimalSI),
+								},
+								EvictionHard: &gardencorev1beta1.KubeletConfigEviction{
+									NodeFSAvailable: pointer.String("1Gi"),
+								},
+							}
+
+							expectCapacity(nodeTemplateOfMachineClass().Capacity, map[corev1.ResourceName]string{
+								"cpu":               "3900m",
+								"memory":            "14236Mi",
+								"gpu":               "1",
+								"ephemeral-storage": "19Gi",
+							})
+						})
+
+						It("should prefer the node template of the worker config", func() {
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"nodeTemplate": {"capacity": {"cpu": "2", "memory": "8Gi"}}
+}`)}
+
+							expectCapacity(nodeTemplateOfMachineClass().Capacity, map[corev1.ResourceName]string{
+								"cpu":    "2",
+								"memory": "8Gi",
+							})
+						})
+
+						It("should fall back to the node template of the worker pool if the machine type has no capabilities", func() {
+							cluster = makeCluster(shootVersion, region, nil, machineImages, 0)
+							w.Spec.Pools[0].NodeTemplate = &extensionsv1alpha1.NodeTemplate{Capacity: corev1.ResourceList{"cpu": resource.MustParse("8")}}
+
+							expectCapacity(nodeTemplateOfMachineClass().Capacity, map[corev1.ResourceName]string{
+								"cpu": "8",
+							})
+						})
+					})
+
+					Context("proximity placement groups", func() {
+						var (
+							proximityPlacementGroupIDZ1 = "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg-z1"
+							proximityPlacementGroupIDZ2 = "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg-z2"
+						)
+
+						BeforeEach(func() {
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"proximityPlacementGroup": {}
+}`)}
+						})
+
+						It("should reference the proximity placement group of the respective zone", func() {
+							var values kubernetes.ApplyOptions
+							w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(
+								apiv1alpha1.ProximityPlacementGroup{PoolName: namePoolZones, Zone: zone1, ID: proximityPlacementGroupIDZ1, Name: "ppg-z1"},
+								apiv1alpha1.ProximityPlacementGroup{PoolName: namePoolZones, Zone: zone2, ID: proximityPlacementGroupIDZ2, Name: "ppg-z2"},
+							)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							Expect(machineClasses[0]["proximityPlacementGroupID"]).To(Equal(proximityPlacementGroupIDZ1))
+							Expect(machineClasses[1]["proximityPlacementGroupID"]).To(Equal(proximityPlacementGroupIDZ2))
+
+							workerPoolHashZ1, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID)
+							Expect(err).NotTo(HaveOccurred())
+							workerPoolHashZ2, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID, subnet2)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(2))
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHashZ1, zone1)))
+							Expect(result[1].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHashZ2, zone2)))
+						})
+
+						It("should fail if the proximity placement group is not recorded in the worker status", func() {
+							w.Status.ProviderStatus = generateWorkerStatusWithProximityPlacementGroups(
+								apiv1alpha1.ProximityPlacementGroup{PoolName: namePoolZones, Zone: zone1, ID: proximityPlacementGroupIDZ1, Name: "ppg-z1"},
+							)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring("not found in the worker provider status")))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("dedicated hosts", func() {
+						const (
+							hostGroupID = "/subscriptions/sample-subscription/resourceGroups/host-rg/providers/Microsoft.Compute/hostGroups/hg"
+							hostID      = hostGroupID + "/hosts/host"
+						)
+
+						var (
+							factory         *factorymock.MockFactory
+							hostGroupClient *factorymock.MockDedicatedHostGroup
+							hostClient      *factorymock.MockDedicatedHost
+
+							hostGroup *armcompute.DedicatedHostGroup
+							host      *armcompute.DedicatedHost
+						)
+
+						BeforeEach(func() {
+							factory = factorymock.NewMockFactory(ctrl)
+							hostGroupClient = factorymock.NewMockDedicatedHostGroup(ctrl)
+							hostClient = factorymock.NewMockDedicatedHost(ctrl)
+							factory.EXPECT().DedicatedHostGroup().AnyTimes().Return(hostGroupClient, nil)
+							factory.EXPECT().DedicatedHost().AnyTimes().Return(hostClient, nil)
+
+							w.Spec.Pools[0].Zones = []string{zone1}
+							w.Spec.Pools[0].Minimum = 1
+							w.Spec.Pools[0].Maximum = 10
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"dedicatedHost": {"hostGroupID": "` + hostGroupID + `"}
+}`)}
+
+							hostGroup = &armcompute.DedicatedHostGroup{
+								ID:    pointer.String(hostGroupID),
+								Zones: []*string{pointer.String(zone1)},
+								Properties: &armcompute.DedicatedHostGroupProperties{
+									Hosts: []*armcompute.SubResourceReadOnly{{ID: pointer.String(hostID)}},
+								},
+							}
+							host = &armcompute.DedicatedHost{
+								ID: pointer.String(hostID),
+								Properties: &armcompute.DedicatedHostProperties{
+									VirtualMachines: []*armcompute.SubResourceReadOnly{
+										{ID: pointer.String(fmt.Sprintf("/subscriptions/sample-subscription/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s--z%s-abcde-fghij", resourceGroupName, namespace, zone1))},
+										{ID: pointer.String(fmt.Sprintf("/subscriptions/sample-subscription/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/other-vm", resourceGroupName))},
+									},
+									InstanceView: &armcompute.DedicatedHostInstanceView{
+										AvailableCapacity: &armcompute.DedicatedHostAvailableCapacity{
+											AllocatableVMs: []*armcompute.DedicatedHostAllocatableVM{
+												{VMSize: pointer.String("other"), Count: pointer.Float64(5)},
+												{VMSize: pointer.String(machineType), Count: pointer.Float64(2)},
+											},
+										},
+									},
+								},
+							}
+						})
+
+						It("should place the machines on the host group and limit the maximum to the capacity of the hosts", func() {
+							var values kubernetes.ApplyOptions
+							hostGroupClient.EXPECT().Get(ctx, "host-rg", "hg").Return(hostGroup, nil)
+							hostClient.EXPECT().Get(ctx, "host-rg", "hg", "host", gomock.Any()).Return(host, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(1))
+							Expect(machineClasses[0]["dedicatedHostGroupID"]).To(Equal(hostGroupID))
+							Expect(machineClasses[0]).NotTo(HaveKey("dedicatedHostID"))
+
+							workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(1))
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+							Expect(result[0].Minimum).To(Equal(int32(1)))
+							// one machine of the pool is already placed on the host and two more can be allocated.
+							Expect(result[0].Maximum).To(Equal(int32(3)))
+						})
+
+						It("should only use the configured host and keep the minimum if the host is exhausted", func() {
+							w.Spec.Pools[0].Minimum = 2
+							w.Spec.Pools[0].ProviderConfig.Raw = []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"dedicatedHost": {"hostGroupID": "` + hostGroupID + `", "hostID": "` + hostID + `"}
+}`)
+							host.Properties.VirtualMachines = nil
+							host.Properties.InstanceView.AvailableCapacity.AllocatableVMs = nil
+							hostGroupClient.EXPECT().Get(ctx, "host-rg", "hg").Return(hostGroup, nil)
+							hostClient.EXPECT().Get(ctx, "host-rg", "hg", "host", gomock.Any()).Return(host, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(1))
+							Expect(result[0].Minimum).To(Equal(int32(2)))
+							Expect(result[0].Maximum).To(Equal(int32(2)))
+						})
+
+						It("should fail with a configuration problem if the host group is not available in the zone", func() {
+							hostGroup.Zones = []*string{pointer.String(zone2)}
+							hostGroupClient.EXPECT().Get(ctx, "host-rg", "hg").Return(hostGroup, nil)
+							hostClient.EXPECT().Get(ctx, "host-rg", "hg", "host", gomock.Any()).Return(host, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(HaveOccurred())
+							Expect(helper.DetermineErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("capacity reservations", func() {
+						const (
+							groupID       = "/subscriptions/sample-subscription/resourceGroups/crg-rg/providers/Microsoft.Compute/capacityReservationGroups/crg"
+							reservationID = groupID + "/capacityReservations/cr"
+						)
+
+						var (
+							factory           *factorymock.MockFactory
+							groupClient       *factorymock.MockCapacityReservationGroup
+							reservationClient *factorymock.MockCapacityReservation
+
+							group       *armcompute.CapacityReservationGroup
+							reservation *armcompute.CapacityReservation
+						)
+
+						BeforeEach(func() {
+							factory = factorymock.NewMockFactory(ctrl)
+							groupClient = factorymock.NewMockCapacityReservationGroup(ctrl)
+							reservationClient = factorymock.NewMockCapacityReservation(ctrl)
+							factory.EXPECT().CapacityReservationGroup().AnyTimes().Return(groupClient, nil)
+							factory.EXPECT().CapacityReservation().AnyTimes().Return(reservationClient, nil)
+
+							w.Spec.Pools[0].Zones = []string{zone1}
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"capacityReservation": {"capacityReservationGroupID": "` + groupID + `"}
+}`)}
+
+							group = &armcompute.CapacityReservationGroup{
+								ID: pointer.String(groupID),
+								Properties: &armcompute.CapacityReservationGroupProperties{
+									CapacityReservations: []*armcompute.SubResourceReadOnly{{ID: pointer.String(reservationID)}},
+								},
+							}
+							reservation = &armcompute.CapacityReservation{
+								ID:    pointer.String(reservationID),
+								SKU:   &armcompute.SKU{Name: pointer.String(strings.ToLower(machineType))},
+								Zones: []*string{pointer.String(zone1)},
+							}
+						})
+
+						It("should associate the machines with the capacity reservation group", func() {
+							var values kubernetes.ApplyOptions
+							groupClient.EXPECT().Get(ctx, "crg-rg", "crg").Return(group, nil)
+							reservationClient.EXPECT().Get(ctx, "crg-rg", "crg", "cr").Return(reservation, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(1))
+							Expect(machineClasses[0]["capacityReservationGroupID"]).To(Equal(groupID))
+
+							workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(1))
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+						})
+
+						It("should fail with a configuration problem if there is no reservation for the machine type", func() {
+							reservation.SKU.Name = pointer.String("other")
+							groupClient.EXPECT().Get(ctx, "crg-rg", "crg").Return(group, nil)
+							reservationClient.EXPECT().Get(ctx, "crg-rg", "crg", "cr").Return(reservation, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(HaveOccurred())
+							Expect(helper.DetermineErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+							Expect(result).To(BeNil())
+						})
+
+						It("should fail with a configuration problem if there is no reservation in the zone", func() {
+							reservation.Zones = []*string{pointer.String(zone2)}
+							groupClient.EXPECT().Get(ctx, "crg-rg", "crg").Return(group, nil)
+							reservationClient.EXPECT().Get(ctx, "crg-rg", "crg", "cr").Return(reservation, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(HaveOccurred())
+							Expect(helper.DetermineErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+							Expect(result).To(BeNil())
+						})
+
+						It("should fail with a configuration problem if the capacity reservation group does not exist", func() {
+							groupClient.EXPECT().Get(ctx, "crg-rg", "crg").Return(nil, nil)
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, factory)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(HaveOccurred())
+							Expect(helper.DetermineErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("additional subnets", func() {
+						const dmzSubnet = "shoot-subnet-dmz"
+
+						BeforeEach(func() {
+							infrastructureStatus.Networks.Subnets = append(infrastructureStatus.Networks.Subnets, apisazure.Subnet{
+								Name:                 dmzSubnet,
+								Purpose:              apisazure.PurposeAdditionalNodes,
+								Zone:                 &zone1,
+								AdditionalSubnetName: pointer.String("dmz"),
+							})
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"subnetName": "dmz"
+}`)}
+						})
+
+						It("should place the machines of all zones in the selected subnet", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							for _, machineClass := range machineClasses {
+								Expect(machineClass["network"]).To(HaveKeyWithValue("subnet", dmzSubnet))
+							}
+
+							workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID, dmzSubnet)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result).To(HaveLen(2))
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+							Expect(result[1].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone2)))
+						})
+
+						It("should fail if the subnet is not part of the infrastructure status", func() {
+							infrastructureStatus.Networks.Subnets = infrastructureStatus.Networks.Subnets[:2]
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring(`failed to find subnet of worker pool`)))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("network interfaces", func() {
+						const storageSubnet = "shoot-subnet-storage"
+
+						BeforeEach(func() {
+							infrastructureStatus.Networks.Subnets = append(infrastructureStatus.Networks.Subnets, apisazure.Subnet{
+								Name:                 storageSubnet,
+								Purpose:              apisazure.PurposeAdditionalNodes,
+								Zone:                 &zone1,
+								AdditionalSubnetName: pointer.String("storage"),
+							})
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"secondaryIPConfigurations": 2,
+"additionalNetworkInterfaces": [{"subnetName": "storage", "secondaryIPConfigurations": 1}, {}]
+}`)}
+						})
+
+						It("should render the additional network interfaces and IP configurations", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							for i, subnet := range []string{subnet1, subnet2} {
+								Expect(machineClasses[i]["network"]).To(HaveKeyWithValue("secondaryIPConfigurations", int32(2)))
+								Expect(machineClasses[i]["network"]).To(HaveKeyWithValue("additionalNetworkInterfaces", []map[string]interface{}{
+									{"subnet": storageSubnet, "secondaryIPConfigurations": int32(1)},
+									{"subnet": subnet},
+								}))
+							}
+
+							workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHash, zone1)))
+						})
+
+						It("should fail if the subnet of a network interface is not part of the infrastructure status", func() {
+							infrastructureStatus.Networks.Subnets = infrastructureStatus.Networks.Subnets[:2]
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring(`failed to determine network interfaces of worker pool`)))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("region-specific machine images", func() {
+						BeforeEach(func() {
+							machineImages[0].Versions[1].Regions = []apiv1alpha1.RegionImage{
+								{Name: "eastus", URN: &machineImageURN},
+								{Name: region, SharedGalleryImageID: &machineImageSharedID},
+							}
+							cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+						})
+
+						It("should use the image of the worker's region and record it in the status", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							for _, machineClass := range values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{}) {
+								Expect(machineClass["image"]).To(Equal(map[string]interface{}{"sharedGalleryImageID": machineImageSharedID}))
+							}
+
+							expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+							Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+
+							workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+							Expect(workerStatus.MachineImages).To(ConsistOf(apiv1alpha1.MachineImage{
+								Name:                 machineImageName,
+								Version:              machineImageVersionID,
+								SharedGalleryImageID: &machineImageSharedID,
+								Architecture:         pointer.String(v1beta1constants.ArchitectureAMD64),
+							}))
+						})
+					})
+
+					Context("marketplace plans", func() {
+						var plan *apiv1alpha1.MachineImagePlan
+
+						BeforeEach(func() {
+							plan = &apiv1alpha1.MachineImagePlan{Name: "plan", Product: "baz", Publisher: "bar"}
+							machineImages[0].Versions[0].Plan = plan
+							cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+							w.Spec.Pools[0].MachineImage.Version = machineImageVersion
+						})
+
+						It("should add the plan of the image to the machine classes and the status", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							for _, machineClass := range values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{}) {
+								Expect(machineClass["image"]).To(Equal(map[string]interface{}{
+									"urn": machineImageURN,
+									"plan": map[string]interface{}{
+										"name":      "plan",
+										"product":   "baz",
+										"publisher": "bar",
+									},
+								}))
+							}
+
+							expectWorkerProviderStatusUpdateToSucceed(ctx, statusWriter)
+							Expect(workerDelegate.UpdateMachineImagesStatus(ctx)).To(Succeed())
+
+							workerStatus := w.Status.ProviderStatus.Object.(*apiv1alpha1.WorkerStatus)
+							Expect(workerStatus.MachineImages).To(ConsistOf(apiv1alpha1.MachineImage{
+								Name:                  machineImageName,
+								Version:               machineImageVersion,
+								URN:                   &machineImageURN,
+								AcceleratedNetworking: pointer.Bool(true),
+								Architecture:          pointer.String(v1beta1constants.ArchitectureAMD64),
+								Plan:                  plan,
+							}))
+						})
+					})
+
+					Context("windows machine images", func() {
+						var (
+							fakeClient         client.Client
+							fakeSecretsManager secretsmanager.Interface
+						)
+
+						BeforeEach(func() {
+							windows := apiv1alpha1.OperatingSystemWindows
+							machineImages[0].Versions[0].OperatingSystem = &windows
+							cluster = makeCluster(shootVersion, region, machineTypes, machineImages, 0)
+							w.Spec.Pools[0].MachineImage.Version = machineImageVersion
+
+							fakeClient = fakeclient.NewClientBuilder().Build()
+							fakeSecretsManager = fakesecretsmanager.New(fakeClient, namespace)
+						})
+
+						It("should render the windows admin credentials and label and taint the windows nodes", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegateWithSecretsManager(c, chartApplier, w, cluster, nil, fakeSecretsManager)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							secretList := &corev1.SecretList{}
+							Expect(fakeClient.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+							Expect(secretList.Items).To(HaveLen(1))
+							for _, machineClass := range values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{}) {
+								Expect(machineClass).NotTo(HaveKey("sshPublicKey"))
+								Expect(machineClass["windows"]).To(Equal(map[string]interface{}{
+									"adminUsername": "gardener",
+									"adminPassword": string(secretList.Items[0].Data["password"]),
+								}))
+							}
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							for _, machineDeployment := range result {
+								Expect(machineDeployment.Labels).To(HaveKeyWithValue("kubernetes.io/os", "windows"))
+								Expect(machineDeployment.Taints).To(ConsistOf(corev1.Taint{Key: "kubernetes.io/os", Value: "windows", Effect: corev1.TaintEffectNoSchedule}))
+							}
+						})
+
+						It("should generate the windows admin credentials once and persist them", func() {
+							_, err := wrapNewWorkerDelegateWithSecretsManager(c, chartApplier, w, cluster, nil, fakeSecretsManager).GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							_, err = wrapNewWorkerDelegateWithSecretsManager(c, chartApplier, w, cluster, nil, fakeSecretsManager).GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+
+							secretList := &corev1.SecretList{}
+							Expect(fakeClient.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+							Expect(secretList.Items).To(HaveLen(1))
+							secret := secretList.Items[0]
+							Expect(secret.Name).To(HavePrefix("windows-admin-credentials"))
+							Expect(secret.Labels).To(HaveKeyWithValue("persist", "true"))
+							Expect(secret.Data).To(HaveKeyWithValue("username", []byte("gardener")))
+							Expect(secret.Data["password"]).To(HaveLen(32))
+						})
+					})
+
+					Context("application security groups", func() {
+						const (
+							webID      = "/subscriptions/sample-subscription/resourceGroups/sample-rg/providers/Microsoft.Network/applicationSecurityGroups/shoot-asg-web"
+							externalID = "/subscriptions/sample-subscription/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/external"
+						)
+
+						BeforeEach(func() {
+							infrastructureStatus.ApplicationSecurityGroups = []apisazure.ApplicationSecurityGroup{{Name: "web", ID: webID}}
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"applicationSecurityGroups": [{"name": "web"}, {"id": "` + externalID + `"}]
+}`)}
+						})
+
+						It("should assign the network interfaces to the application security groups", func() {
+							var values kubernetes.ApplyOptions
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							for _, machineClass := range machineClasses {
+								Expect(machineClass["network"]).To(HaveKeyWithValue("applicationSecurityGroupIDs", []string{webID, externalID}))
+							}
+
+							workerPoolHashZ1, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, identityID, "applicationSecurityGroups", webID, externalID)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHashZ1, zone1)))
+						})
+
+						It("should fail if the application security group is not part of the infrastructure status", func() {
+							infrastructureStatus.ApplicationSecurityGroups = nil
+							w.Spec.InfrastructureProviderStatus = &runtime.RawExtension{Raw: encode(infrastructureStatus)}
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring(`application security group "web" not found`)))
+							Expect(result).To(BeNil())
+						})
+					})
+
+					Context("VM extensions", func() {
+						var protectedSettings string
+
+						setExtensions := func(extensions string) {
+							w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"extensions": ` + extensions + `
+}`)}
+						}
+
+						BeforeEach(func() {
+							protectedSettings = `{"workspaceKey":"secret"}`
+							setExtensions(`[{"name": "monitor", "publisher": "Microsoft.Azure.Monitor", "type": "AzureMonitorLinuxAgent", "typeHandlerVersion": "1.29", "settings": {"workspaceId": "abc"}, "protectedSettingsSecretRef": "monitor-key"}]`)
+							cluster.Shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
+								Name:        "monitor-key",
+								ResourceRef: autoscalingv1.CrossVersionObjectReference{Kind: "Secret", Name: "monitor", APIVersion: "v1"},
+							}}
+						})
+
+						expectProtectedSettingsSecret := func() {
+							c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "ref-monitor"}, gomock.AssignableToTypeOf(&corev1.Secret{})).
+								DoAndReturn(func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret, _ ...client.GetOption) error {
+									secret.Data = map[string][]byte{"protectedSettings": []byte(protectedSettings)}
+									return nil
+								}).AnyTimes()
+						}
+
+						It("should render the extensions and their protected settings into the machine classes", func() {
+							var values kubernetes.ApplyOptions
+							expectProtectedSettingsSecret()
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+								DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+									for _, o := range opts {
+										o.MutateApplyOptions(&values)
+									}
+									return nil
+								})
+							Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+							machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+							Expect(machineClasses).To(HaveLen(2))
+							for _, machineClass := range machineClasses {
+								Expect(machineClass["extensions"]).To(Equal([]map[string]interface{}{{
+									"name":                    "monitor",
+									"publisher":               "Microsoft.Azure.Monitor",
+									"type":                    "AzureMonitorLinuxAgent",
+									"typeHandlerVersion":      "1.29",
+									"autoUpgradeMinorVersion": true,
+									"settings":                map[string]interface{}{"workspaceId": "abc"},
+									"protectedSettings":       `{"workspaceKey":"secret"}`,
+								}}))
+							}
+
+							// only the identity and the major version of the extensions are part of the hash.
+							pool := *w.Spec.Pools[0].DeepCopy()
+							pool.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","extensions":[{"name":"monitor","publisher":"Microsoft.Azure.Monitor","type":"AzureMonitorLinuxAgent","typeHandlerVersion":"1"}],"kind":"WorkerConfig"}`)}
+							workerPoolHashZ1, err := worker.WorkerPoolHash(pool, cluster, identityID)
+							Expect(err).NotTo(HaveOccurred())
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(result[0].ClassName).To(Equal(fmt.Sprintf("%s-%s-%s-z%s", namespace, namePoolZones, workerPoolHashZ1, zone1)))
+						})
+
+						It("should only change the worker pool hash if an extension is replaced", func() {
+							expectProtectedSettingsSecret()
+							generateClassName := func() string {
+								result, err := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil).GenerateMachineDeployments(ctx)
+								Expect(err).NotTo(HaveOccurred())
+								return result[0].ClassName
+							}
+							className := generateClassName()
+
+							setExtensions(`[{"name":"monitor","publisher":"Microsoft.Azure.Monitor","type":"AzureMonitorLinuxAgent","typeHandlerVersion":"1.29","settings":{"workspaceId":"abc"},"protectedSettingsSecretRef":"monitor-key"}]`)
+							Expect(generateClassName()).To(Equal(className))
+
+							setExtensions(`[{"name": "monitor", "publisher": "Microsoft.Azure.Monitor", "type": "AzureMonitorLinuxAgent", "typeHandlerVersion": "1.30", "autoUpgradeMinorVersion": false, "settings": {"workspaceId": "def"}, "protectedSettingsSecretRef": "monitor-key"}]`)
+							Expect(generateClassName()).To(Equal(className))
+
+							protectedSettings = `{"workspaceKey":"rotated"}`
+							Expect(generateClassName()).To(Equal(className))
+
+							setExtensions(`[{"name": "monitor", "publisher": "Microsoft.Azure.Monitor", "type": "AzureMonitorLinuxAgent", "typeHandlerVersion": "2.0", "settings": {"workspaceId": "def"}, "protectedSettingsSecretRef": "monitor-key"}]`)
+							majorVersionClassName := generateClassName()
+							Expect(majorVersionClassName).NotTo(Equal(className))
+
+							setExtensions(`[{"name": "monitor", "publisher": "Microsoft.Azure.Security", "type": "AzureMonitorLinuxAgent", "typeHandlerVersion": "2.0", "settings": {"workspaceId": "def"}, "protectedSettingsSecretRef": "monitor-key"}]`)
+							Expect(generateClassName()).NotTo(BeElementOf(className, majorVersionClassName))
+						})
+
+						It("should fail if the referenced secret does not contain the protected settings", func() {
+							c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "ref-monitor"}, gomock.AssignableToTypeOf(&corev1.Secret{}))
+							workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+							result, err := workerDelegate.GenerateMachineDeployments(ctx)
+							Expect(err).To(MatchError(ContainSubstring(`referenced secret "monitor" does not contain key "protectedSettings"`)))
+							Expect(result).To(BeNil())
+						})
+					})
 				})
 			})
 
@@ -765,6 +1719,164 @@ var _ = Describe("Machines", func() {
 				Expect(result).To(BeNil())
 			})
 
+			It("should place the OS disk on the local disk of the machine if it is ephemeral", func() {
+				var values kubernetes.ApplyOptions
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"osDisk": {"ephemeralPlacement": "ResourceDisk"}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[1]["osDisk"]).To(Equal(map[string]interface{}{
+					"size":               volumeSize,
+					"ephemeralPlacement": "ResourceDisk",
+					"caching":            "ReadOnly",
+				}))
+
+				workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster, identityID, "ephemeral", "ResourceDisk")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineClasses[1]["name"]).To(Equal(fmt.Sprintf("%s-%s-%s", namespace, namePool2, workerPoolHash)))
+			})
+
+			It("should apply the data volume settings of the worker config", func() {
+				var values kubernetes.ApplyOptions
+				dataVolume2Type = "UltraSSD_LRS"
+				w.Spec.Pools[0].DataVolumes[1].Type = &dataVolume2Type
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"dataVolumes": [
+  {"name": "` + dataVolume1Name + `", "caching": "ReadOnly"},
+  {"name": "` + dataVolume2Name + `", "iops": 5000, "throughputMBps": 200}
+]
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[0]["ultraSSDEnabled"]).To(BeTrue())
+				Expect(machineClasses[0]["dataDisks"]).To(Equal([]map[string]interface{}{
+					{
+						"name":               dataVolume2Name,
+						"lun":                int32(0),
+						"diskSizeGB":         dataVolume2Size,
+						"caching":            "None",
+						"storageAccountType": dataVolume2Type,
+						"diskIOPSReadWrite":  int64(5000),
+						"diskMBpsReadWrite":  int64(200),
+					},
+					{
+						"name":       dataVolume1Name,
+						"lun":        int32(1),
+						"diskSizeGB": dataVolume1Size,
+						"caching":    "ReadOnly",
+					},
+				}))
+				Expect(machineClasses[1]).NotTo(HaveKey("ultraSSDEnabled"))
+
+				// The IOPS and the throughput are not part of the hash as they can be changed for existing disks.
+				pool := w.Spec.Pools[0].DeepCopy()
+				pool.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","dataVolumes":[{"caching":"ReadOnly","name":"` + dataVolume1Name + `"},{"name":"` + dataVolume2Name + `"}],"kind":"WorkerConfig"}`)}
+				workerPoolHash, err := worker.WorkerPoolHash(*pool, cluster, fmt.Sprintf("%dGi", dataVolume2Size), dataVolume2Type, fmt.Sprintf("%dGi", dataVolume1Size), identityID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineClasses[0]["name"]).To(Equal(fmt.Sprintf("%s-%s-%s", namespace, namePool1, workerPoolHash)))
+			})
+
+			It("should only roll the machines if data volume settings change which cannot be updated in place", func() {
+				machineClassName := func(dataVolumes string) string {
+					var values kubernetes.ApplyOptions
+					w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"dataVolumes": ` + dataVolumes + `
+}`)}
+					workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							for _, o := range opts {
+								o.MutateApplyOptions(&values)
+							}
+							return nil
+						})
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					return values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})[0]["name"].(string)
+				}
+
+				dataVolume2Type = "UltraSSD_LRS"
+				w.Spec.Pools[0].DataVolumes[1].Type = &dataVolume2Type
+
+				name := machineClassName(`[{"name": "` + dataVolume2Name + `", "iops": 5000, "throughputMBps": 200}]`)
+				Expect(machineClassName(`[{"name": "` + dataVolume2Name + `", "iops": 8000, "throughputMBps": 200}]`)).To(Equal(name))
+				Expect(machineClassName(`[{"name": "` + dataVolume2Name + `", "iops": 8000, "throughputMBps": 400}]`)).To(Equal(name))
+				Expect(machineClassName(`[{"name": "` + dataVolume2Name + `", "iops": 8000, "throughputMBps": 400, "caching": "ReadOnly"}]`)).NotTo(Equal(name))
+			})
+
+			It("should render the security profile of confidential VMs", func() {
+				var values kubernetes.ApplyOptions
+				w.Spec.Pools[1].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"security": {"type": "ConfidentialVM", "osDiskEncryptionType": "DiskWithVMGuestState"}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[0]).NotTo(HaveKey("security"))
+				Expect(machineClasses[1]["security"]).To(Equal(map[string]interface{}{
+					"type":              "ConfidentialVM",
+					"secureBootEnabled": true,
+					"vTpmEnabled":       true,
+				}))
+				Expect(machineClasses[1]["osDisk"]).To(HaveKeyWithValue("securityEncryptionType", "DiskWithVMGuestState"))
+
+				workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[1], cluster, identityID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineClasses[1]["name"]).To(Equal(fmt.Sprintf("%s-%s-%s", namespace, namePool2, workerPoolHash)))
+			})
+
+			It("should fail because spot machines cannot be placed in an availability set", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"spot": {}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).To(MatchError(ContainSubstring("availability set")))
+				Expect(result).To(BeNil())
+			})
+
 			It("should fail because the volume size cannot be decoded", func() {
 				w.Spec.Pools[0].Volume.Size = "not-decodeable"
 				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
@@ -802,6 +1914,173 @@ var _ = Describe("Machines", func() {
 				Expect(resultSettings.MaxEvictRetries).To(Equal(&testMaxEvictRetries))
 				Expect(resultSettings.NodeConditions).To(Equal(&resultNodeConditions))
 			})
+
+			It("should encrypt the disks according to the worker config and the infrastructure config defaults", func() {
+				var (
+					defaultDiskEncryptionSetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/default"
+					poolDiskEncryptionSetID    = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/pool"
+					values                     kubernetes.ApplyOptions
+				)
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"diskEncryption": {"diskEncryptionSetID": "` + defaultDiskEncryptionSetID + `", "encryptionAtHost": true}
+}`)}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"diskEncryption": {"diskEncryptionSetID": "` + poolDiskEncryptionSetID + `"}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[0]["encryptionAtHost"]).To(BeTrue())
+				Expect(machineClasses[0]["osDisk"]).To(HaveKeyWithValue("diskEncryptionSetID", poolDiskEncryptionSetID))
+				for _, dataDisk := range machineClasses[0]["dataDisks"].([]map[string]interface{}) {
+					Expect(dataDisk).To(HaveKeyWithValue("diskEncryptionSetID", poolDiskEncryptionSetID))
+				}
+				Expect(machineClasses[1]["encryptionAtHost"]).To(BeTrue())
+				Expect(machineClasses[1]["osDisk"]).To(HaveKeyWithValue("diskEncryptionSetID", defaultDiskEncryptionSetID))
+
+				workerPoolHash, err := worker.WorkerPoolHash(w.Spec.Pools[0], cluster, fmt.Sprintf("%dGi", dataVolume2Size), dataVolume2Type, fmt.Sprintf("%dGi", dataVolume1Size), identityID, poolDiskEncryptionSetID, "encryptionAtHost")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(machineClasses[0]["name"]).To(Equal(fmt.Sprintf("%s-%s-%s", namespace, namePool1, workerPoolHash)))
+			})
+
+			It("should render the data disks into the machine class chart", func() {
+				var (
+					diskEncryptionSetID = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Compute/diskEncryptionSets/pool"
+					values              kubernetes.ApplyOptions
+				)
+				dataVolume2Type = "UltraSSD_LRS"
+				w.Spec.Pools[0].DataVolumes[1].Type = &dataVolume2Type
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"diskEncryption": {"diskEncryptionSetID": "` + diskEncryptionSetID + `"},
+"dataVolumes": [{"name": "` + dataVolume2Name + `", "iops": 5000, "throughputMBps": 200}]
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				renderer := chartrenderer.NewWithServerVersion(&version.Info{GitVersion: "v1.28.0"})
+				release, err := renderer.RenderEmbeddedFS(charts.InternalChart, filepath.Join("internal", "machineclass"), "machineclass", namespace, values.Values)
+				Expect(err).NotTo(HaveOccurred())
+
+				name := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})[0]["name"].(string)
+				machineClass := &machinev1alpha1.MachineClass{}
+				Expect(yaml.Unmarshal([]byte(release.Files()["machineclass/templates/machineclass.yaml"]["machineclass/"+name]), machineClass)).To(Succeed())
+
+				var providerSpec struct {
+					Properties struct {
+						StorageProfile struct {
+							OSDisk    map[string]interface{}   `json:"osDisk"`
+							DataDisks []map[string]interface{} `json:"dataDisks"`
+						} `json:"storageProfile"`
+					} `json:"properties"`
+				}
+				Expect(json.Unmarshal(machineClass.ProviderSpec.Raw, &providerSpec)).To(Succeed())
+				Expect(providerSpec.Properties.StorageProfile.OSDisk).To(HaveKeyWithValue("managedDisk", HaveKeyWithValue("diskEncryptionSet", map[string]interface{}{"id": diskEncryptionSetID})))
+				Expect(providerSpec.Properties.StorageProfile.DataDisks).To(Equal([]map[string]interface{}{
+					{
+						"name":               dataVolume2Name,
+						"lun":                float64(0),
+						"caching":            "None",
+						"diskSizeGB":         float64(dataVolume2Size),
+						"storageAccountType": dataVolume2Type,
+						"managedDisk": map[string]interface{}{
+							"diskEncryptionSet": map[string]interface{}{"id": diskEncryptionSetID},
+						},
+						"diskIOPSReadWrite": float64(5000),
+						"diskMBpsReadWrite": float64(200),
+					},
+					{
+						"name":       dataVolume1Name,
+						"lun":        float64(1),
+						"caching":    "None",
+						"diskSizeGB": float64(dataVolume1Size),
+						"managedDisk": map[string]interface{}{
+							"diskEncryptionSet": map[string]interface{}{"id": diskEncryptionSetID},
+						},
+					},
+				}))
+			})
+
+			It("should enable boot diagnostics according to the worker config and the infrastructure config defaults", func() {
+				var (
+					storageURI = "https://mystorageaccount.blob.core.windows.net/"
+					values     kubernetes.ApplyOptions
+				)
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"bootDiagnostics": {"enabled": true}
+}`)}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"bootDiagnostics": {"enabled": true, "storageURI": "` + storageURI + `"}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[0]["bootDiagnostics"]).To(Equal(map[string]interface{}{"storageURI": storageURI}))
+				Expect(machineClasses[1]["bootDiagnostics"]).To(Equal(map[string]interface{}{}))
+			})
+
+			It("should not enable boot diagnostics if the worker config disables them", func() {
+				var values kubernetes.ApplyOptions
+				cluster.Shoot.Spec.Provider.InfrastructureConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "InfrastructureConfig",
+"bootDiagnostics": {"enabled": true}
+}`)}
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{Raw: []byte(`{
+"apiVersion": "azure.provider.extensions.gardener.cloud/v1alpha1",
+"kind": "WorkerConfig",
+"bootDiagnostics": {"enabled": false}
+}`)}
+				workerDelegate := wrapNewWorkerDelegate(c, chartApplier, w, cluster, nil)
+
+				chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						for _, o := range opts {
+							o.MutateApplyOptions(&values)
+						}
+						return nil
+					})
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				machineClasses := values.Values.(map[string]interface{})["machineClasses"].([]map[string]interface{})
+				Expect(machineClasses[0]).NotTo(HaveKey("bootDiagnostics"))
+				Expect(machineClasses[1]).To(HaveKey("bootDiagnostics"))
+			})
 		})
 	})
 
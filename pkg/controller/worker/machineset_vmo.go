@@ -12,6 +12,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"k8s.io/utils/pointer"
 
@@ -21,7 +22,28 @@ import (
 	azureclient "github.com/gardener/gardener-extension-provider-azure/pkg/azure/client"
 )
 
-func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus) ([]azureapi.VmoDependency, error) {
+// isVmoRequired determines if the machines of a worker pool are placed in a VMO. This is the case for all worker pools
+// of VMO clusters and for the worker pools which are migrated away from the availability set of a cluster.
+func isVmoRequired(infrastructureStatus *azureapi.InfrastructureStatus, workerConfig *azureapi.WorkerConfig) bool {
+	return azureapihelper.IsVmoRequired(infrastructureStatus) || (!infrastructureStatus.Zoned && azureapihelper.IsWorkerPoolMigratedToVmo(workerConfig))
+}
+
+// vmoWorkerPools returns the worker pools whose machines are placed in VMOs.
+func (w *workerDelegate) vmoWorkerPools(infrastructureStatus *azureapi.InfrastructureStatus) ([]extensionsv1alpha1.WorkerPool, error) {
+	var pools []extensionsv1alpha1.WorkerPool
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, err
+		}
+		if isVmoRequired(infrastructureStatus, workerConfig) {
+			pools = append(pools, pool)
+		}
+	}
+	return pools, nil
+}
+
+func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus, pools []extensionsv1alpha1.WorkerPool) ([]azureapi.VmoDependency, error) {
 	var vmoDependencies = copyVmoDependencies(workerProviderStatus)
 
 	vmoClient, err := w.clientFactory.Vmss()
@@ -35,7 +57,7 @@ func (w *workerDelegate) reconcileVmoDependencies(ctx context.Context, infrastru
 	}
 
 	// Deploy workerpool dependencies and store their status to be persistent in the worker provider status.
-	for _, workerPool := range w.worker.Spec.Pools {
+	for _, workerPool := range pools {
 		vmoDependencyStatus, err := w.reconcileVMO(ctx, vmoClient, vmoDependencies, infrastructureStatus.ResourceGroup.Name, workerPool.Name, faultDomainCount)
 		if err != nil {
 			return vmoDependencies, err
@@ -160,8 +182,8 @@ func cleanupOrphanVMODependencies(ctx context.Context, client azureclient.Vmss,
 	return nil
 }
 
-func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, workerPoolName string) (*azureapi.VmoDependency, error) {
-	if !azureapihelper.IsVmoRequired(infrastructureStatus) {
+func (w *workerDelegate) determineWorkerPoolVmoDependency(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerStatus *azureapi.WorkerStatus, workerPoolName string, workerConfig *azureapi.WorkerConfig) (*azureapi.VmoDependency, error) {
+	if !isVmoRequired(infrastructureStatus, workerConfig) {
 		return nil, nil
 	}
 
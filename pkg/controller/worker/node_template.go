@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// resourceGPU is the capacity key for GPUs which the cluster autoscaler maps to the GPU resource of the nodes.
+const resourceGPU corev1.ResourceName = "gpu"
+
+// The defaults of the kubelet configuration which Gardener applies if they are not configured in the shoot, see
+// https://github.com/gardener/gardener/blob/v1.87.1/pkg/component/extensions/operatingsystemconfig/original/components/kubelet/config.go
+const (
+	defaultEvictionHardMemoryAvailable = "100Mi"
+	defaultEvictionHardNodeFSAvailable = "5%"
+)
+
+var (
+	defaultKubeReservedCPU    = resource.MustParse("80m")
+	defaultKubeReservedMemory = resource.MustParse("1Gi")
+)
+
+// computeNodeTemplateCapacity returns the capacity of the node template of the given worker pool derived from the
+// capabilities of its machine type in the cloud profile. It returns nil if the cloud profile does not declare the vCPUs
+// and memory of the machine type.
+// The cluster autoscaler treats the capacity of the node template as allocatable resources of new nodes, hence the
+// resources reserved by the kubelet are subtracted. Like Gardener, the kubelet defaults are used for the reservations and
+// eviction thresholds which are not configured.
+func (w *workerDelegate) computeNodeTemplateCapacity(pool extensionsv1alpha1.WorkerPool) (corev1.ResourceList, error) {
+	machineType := w.findMachineType(pool.MachineType)
+	if machineType == nil || machineType.CPU == nil || machineType.Memory == nil {
+		return nil, nil
+	}
+
+	capacity := corev1.ResourceList{
+		corev1.ResourceCPU:    machineType.CPU.DeepCopy(),
+		corev1.ResourceMemory: machineType.Memory.DeepCopy(),
+	}
+	if machineType.GPU != nil && !machineType.GPU.IsZero() {
+		capacity[resourceGPU] = machineType.GPU.DeepCopy()
+	}
+
+	ephemeralStorage, err := ephemeralStorageOfPool(pool, machineType)
+	if err != nil {
+		return nil, err
+	}
+	if ephemeralStorage != nil {
+		capacity[corev1.ResourceEphemeralStorage] = *ephemeralStorage
+	}
+
+	kubelet := w.kubeletConfig(pool.Name)
+	if kubelet == nil {
+		kubelet = &gardencorev1beta1.KubeletConfig{}
+	}
+
+	kubeReserved := &gardencorev1beta1.KubeletConfigReserved{}
+	if kubelet.KubeReserved != nil {
+		kubeReserved = kubelet.KubeReserved
+	}
+	subtract(capacity, corev1.ResourceCPU, quantityOrDefault(kubeReserved.CPU, defaultKubeReservedCPU))
+	subtract(capacity, corev1.ResourceMemory, quantityOrDefault(kubeReserved.Memory, defaultKubeReservedMemory))
+	subtract(capacity, corev1.ResourceEphemeralStorage, kubeReserved.EphemeralStorage)
+
+	if systemReserved := kubelet.SystemReserved; systemReserved != nil {
+		subtract(capacity, corev1.ResourceCPU, systemReserved.CPU)
+		subtract(capacity, corev1.ResourceMemory, systemReserved.Memory)
+		subtract(capacity, corev1.ResourceEphemeralStorage, systemReserved.EphemeralStorage)
+	}
+
+	evictionHard := &gardencorev1beta1.KubeletConfigEviction{}
+	if kubelet.EvictionHard != nil {
+		evictionHard = kubelet.EvictionHard
+	}
+	for name, threshold := range map[corev1.ResourceName]string{
+		corev1.ResourceMemory:           pointer.StringDeref(evictionHard.MemoryAvailable, defaultEvictionHardMemoryAvailable),
+		corev1.ResourceEphemeralStorage: pointer.StringDeref(evictionHard.NodeFSAvailable, defaultEvictionHardNodeFSAvailable),
+	} {
+		total, ok := capacity[name]
+		if !ok {
+			continue
+		}
+		quantity, err := evictionThreshold(threshold, total)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse eviction threshold for %s: %w", name, err)
+		}
+		subtract(capacity, name, quantity)
+	}
+
+	return capacity, nil
+}
+
+// findMachineType returns the machine type with the given name from the cloud profile config.
+func (w *workerDelegate) findMachineType(name string) *azureapi.MachineType {
+	for i, machineType := range w.cloudProfileConfig.MachineTypes {
+		if machineType.Name == name {
+			return &w.cloudProfileConfig.MachineTypes[i]
+		}
+	}
+	return nil
+}
+
+// kubeletConfig returns the kubelet configuration of the worker pool with the given name. Like Gardener, it uses the
+// configuration of the worker pool if present and the one of the shoot otherwise.
+func (w *workerDelegate) kubeletConfig(poolName string) *gardencorev1beta1.KubeletConfig {
+	if w.cluster == nil || w.cluster.Shoot == nil {
+		return nil
+	}
+	for _, worker := range w.cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name == poolName && worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
+			return worker.Kubernetes.Kubelet
+		}
+	}
+	return w.cluster.Shoot.Spec.Kubernetes.Kubelet
+}
+
+// ephemeralStorageOfPool returns the ephemeral storage of the nodes of the given worker pool, which is the size of the
+// kubelet data volume or of the root volume. If neither is configured, the ephemeral storage of the machine type is used.
+func ephemeralStorageOfPool(pool extensionsv1alpha1.WorkerPool, machineType *azureapi.MachineType) (*resource.Quantity, error) {
+	size := ""
+	if pool.KubeletDataVolumeName != nil {
+		for _, volume := range pool.DataVolumes {
+			if volume.Name == *pool.KubeletDataVolumeName {
+				size = volume.Size
+			}
+		}
+	} else if pool.Volume != nil {
+		size = pool.Volume.Size
+	}
+
+	if size == "" {
+		if machineType.EphemeralStorage == nil {
+			return nil, nil
+		}
+		quantity := machineType.EphemeralStorage.DeepCopy()
+		return &quantity, nil
+	}
+
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse volume size %q of worker pool %q: %w", size, pool.Name, err)
+	}
+	return &quantity, nil
+}
+
+// evictionThreshold returns the quantity of the given eviction threshold, which is either an absolute quantity or a
+// percentage of the given total.
+func evictionThreshold(threshold string, total resource.Quantity) (*resource.Quantity, error) {
+	if percentage, ok := strings.CutSuffix(threshold, "%"); ok {
+		value, err := strconv.ParseFloat(percentage, 64)
+		if err != nil {
+			return nil, err
+		}
+		return resource.NewQuantity(int64(float64(total.Value())*value/100), total.Format), nil
+	}
+
+	quantity, err := resource.ParseQuantity(threshold)
+	if err != nil {
+		return nil, err
+	}
+	return &quantity, nil
+}
+
+func quantityOrDefault(quantity *resource.Quantity, defaultQuantity resource.Quantity) *resource.Quantity {
+	if quantity != nil {
+		return quantity
+	}
+	return &defaultQuantity
+}
+
+// subtract subtracts the given quantity from the resource of the given capacity without falling below zero.
+func subtract(capacity corev1.ResourceList, name corev1.ResourceName, quantity *resource.Quantity) {
+	total, ok := capacity[name]
+	if !ok || quantity == nil {
+		return
+	}
+	total.Sub(*quantity)
+	if total.Sign() < 0 {
+		total = *resource.NewQuantity(0, total.Format)
+	}
+	capacity[name] = total
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+
+	azureapi "github.com/gardener/gardener-extension-provider-azure/pkg/apis/azure"
+)
+
+// desiredProximityPlacementGroups returns the proximity placement groups which are required by the worker pools. The
+// names of the groups are prefixed with the technical id of the shoot like the other resources created for it, groups
+// which were created before keep their name as they are looked up by worker pool and zone. The ids of the returned
+// groups are not set.
+func (w *workerDelegate) desiredProximityPlacementGroups() ([]azureapi.ProximityPlacementGroup, error) {
+	var proximityPlacementGroups []azureapi.ProximityPlacementGroup
+
+	// All proximity placement groups are released as the Worker is intended to be deleted.
+	if w.worker.DeletionTimestamp != nil {
+		return proximityPlacementGroups, nil
+	}
+
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := w.decodeWorkerConfig(pool)
+		if err != nil {
+			return nil, err
+		}
+		if workerConfig.ProximityPlacementGroup == nil {
+			continue
+		}
+
+		// The machines of a proximity placement group are located in the same datacenter, hence a group cannot span
+		// multiple zones.
+		for _, zone := range pool.Zones {
+			proximityPlacementGroups = append(proximityPlacementGroups, azureapi.ProximityPlacementGroup{
+				PoolName: pool.Name,
+				Zone:     zone,
+				Name:     fmt.Sprintf("%s-ppg-%s-z%s", w.worker.Namespace, pool.Name, zone),
+			})
+		}
+	}
+	return proximityPlacementGroups, nil
+}
+
+func (w *workerDelegate) reconcileProximityPlacementGroups(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus, desired []azureapi.ProximityPlacementGroup) ([]azureapi.ProximityPlacementGroup, error) {
+	var proximityPlacementGroups = workerProviderStatus.DeepCopy().ProximityPlacementGroups
+
+	client, err := w.clientFactory.ProximityPlacementGroup()
+	if err != nil {
+		return proximityPlacementGroups, err
+	}
+
+	for _, desiredGroup := range desired {
+		// Check if the proximity placement group in the status still exists on Azure.
+		if existing := findProximityPlacementGroup(proximityPlacementGroups, desiredGroup.PoolName, desiredGroup.Zone); existing != nil {
+			group, err := client.Get(ctx, infrastructureStatus.ResourceGroup.Name, existing.Name)
+			if err != nil {
+				return proximityPlacementGroups, err
+			}
+			if group != nil {
+				continue
+			}
+		}
+
+		group, err := client.CreateOrUpdate(ctx, infrastructureStatus.ResourceGroup.Name, desiredGroup.Name, armcompute.ProximityPlacementGroup{
+			Location: to.Ptr(w.worker.Spec.Region),
+			Properties: &armcompute.ProximityPlacementGroupProperties{
+				ProximityPlacementGroupType: to.Ptr(armcompute.ProximityPlacementGroupTypeStandard),
+			},
+		})
+		if err != nil {
+			return proximityPlacementGroups, err
+		}
+
+		desiredGroup.ID = *group.ID
+		proximityPlacementGroups = appendProximityPlacementGroup(proximityPlacementGroups, desiredGroup)
+	}
+
+	return proximityPlacementGroups, nil
+}
+
+func (w *workerDelegate) cleanupProximityPlacementGroups(ctx context.Context, infrastructureStatus *azureapi.InfrastructureStatus, workerProviderStatus *azureapi.WorkerStatus) ([]azureapi.ProximityPlacementGroup, error) {
+	var proximityPlacementGroups = workerProviderStatus.DeepCopy().ProximityPlacementGroups
+
+	desired, err := w.desiredProximityPlacementGroups()
+	if err != nil {
+		return proximityPlacementGroups, err
+	}
+
+	client, err := w.clientFactory.ProximityPlacementGroup()
+	if err != nil {
+		return proximityPlacementGroups, err
+	}
+
+	// Delete the proximity placement groups which are not required anymore. This is done after the machines of the
+	// worker pools have been rolled, as groups which are still used by machines cannot be deleted.
+	for _, group := range workerProviderStatus.ProximityPlacementGroups {
+		if findProximityPlacementGroup(desired, group.PoolName, group.Zone) != nil {
+			continue
+		}
+		if err := client.Delete(ctx, infrastructureStatus.ResourceGroup.Name, group.Name); err != nil {
+			return proximityPlacementGroups, err
+		}
+		proximityPlacementGroups = removeProximityPlacementGroup(proximityPlacementGroups, group.PoolName, group.Zone)
+	}
+
+	return proximityPlacementGroups, nil
+}
+
+func findProximityPlacementGroup(proximityPlacementGroups []azureapi.ProximityPlacementGroup, poolName, zone string) *azureapi.ProximityPlacementGroup {
+	for i := range proximityPlacementGroups {
+		if proximityPlacementGroups[i].PoolName == poolName && proximityPlacementGroups[i].Zone == zone {
+			return &proximityPlacementGroups[i]
+		}
+	}
+	return nil
+}
+
+// appendProximityPlacementGroup appends a proximity placement group to the list. An existing group for the same
+// worker pool and zone is replaced.
+func appendProximityPlacementGroup(proximityPlacementGroups []azureapi.ProximityPlacementGroup, proximityPlacementGroup azureapi.ProximityPlacementGroup) []azureapi.ProximityPlacementGroup {
+	if existing := findProximityPlacementGroup(proximityPlacementGroups, proximityPlacementGroup.PoolName, proximityPlacementGroup.Zone); existing != nil {
+		*existing = proximityPlacementGroup
+		return proximityPlacementGroups
+	}
+	return append(proximityPlacementGroups, proximityPlacementGroup)
+}
+
+func removeProximityPlacementGroup(proximityPlacementGroups []azureapi.ProximityPlacementGroup, poolName, zone string) []azureapi.ProximityPlacementGroup {
+	var res []azureapi.ProximityPlacementGroup
+	for _, group := range proximityPlacementGroups {
+		if group.PoolName != poolName || group.Zone != zone {
+			res = append(res, group)
+		}
+	}
+	return res
+}
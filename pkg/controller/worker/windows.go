@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// windowsAdminCredentialsSecretName is the name of the secret config of the credentials of the local administrator
+	// of all Windows machines of the shoot.
+	windowsAdminCredentialsSecretName = "windows-admin-credentials"
+	// windowsAdminUsername is the name of the local administrator of Windows machines. Azure rejects well-known names
+	// like "administrator" or "admin".
+	windowsAdminUsername = "gardener"
+
+	windowsAdminCredentialsUsernameKey = "username"
+	windowsAdminCredentialsPasswordKey = "password"
+)
+
+type windowsAdminCredentials struct {
+	username string
+	password string
+}
+
+// SecretData returns the data of the secret containing the credentials.
+func (c *windowsAdminCredentials) SecretData() map[string][]byte {
+	return map[string][]byte{
+		windowsAdminCredentialsUsernameKey: []byte(c.username),
+		windowsAdminCredentialsPasswordKey: []byte(c.password),
+	}
+}
+
+// windowsAdminCredentialsConfig is the secret config of the credentials of the local administrator of Windows machines.
+type windowsAdminCredentialsConfig struct {
+	Name     string
+	Username string
+}
+
+var _ secretsutils.ConfigInterface = &windowsAdminCredentialsConfig{}
+
+// GetName returns the name of the secret config.
+func (c *windowsAdminCredentialsConfig) GetName() string {
+	return c.Name
+}
+
+// Generate generates the credentials with a new password.
+func (c *windowsAdminCredentialsConfig) Generate() (secretsutils.DataInterface, error) {
+	password, err := generateWindowsAdminPassword()
+	if err != nil {
+		return nil, err
+	}
+	return &windowsAdminCredentials{username: c.Username, password: password}, nil
+}
+
+// getWindowsAdminCredentials returns the credentials of the local administrator of Windows machines. They are generated
+// once by the secrets manager, which persists them so that they are restored after a control plane migration. This way
+// the machine classes of the Windows worker pools do not change.
+func (w *workerDelegate) getWindowsAdminCredentials(ctx context.Context) (*windowsAdminCredentials, error) {
+	secret, err := w.secretsManager.Generate(ctx, &windowsAdminCredentialsConfig{
+		Name:     windowsAdminCredentialsSecretName,
+		Username: windowsAdminUsername,
+	}, secretsmanager.Persist())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret with the windows admin credentials: %w", err)
+	}
+	return &windowsAdminCredentials{
+		username: string(secret.Data[windowsAdminCredentialsUsernameKey]),
+		password: string(secret.Data[windowsAdminCredentialsPasswordKey]),
+	}, nil
+}
+
+// generateWindowsAdminPassword generates a password which satisfies the complexity requirements of Azure, i.e. it
+// contains lower case characters, upper case characters and digits.
+func generateWindowsAdminPassword() (string, error) {
+	var password string
+	for _, charset := range []string{
+		"abcdefghijklmnopqrstuvwxyz",
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+		"0123456789",
+		"0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	} {
+		part, err := utils.GenerateRandomStringFromCharset(8, charset)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate windows admin password: %w", err)
+		}
+		password += part
+	}
+	return password, nil
+}
+
+// addOperatingSystemLabel labels the nodes of Windows worker pools with their operating system, so that workloads can
+// select them before the kubelet reports the label itself.
+func addOperatingSystemLabel(labels map[string]string, windows bool) map[string]string {
+	if !windows {
+		return labels
+	}
+	return utils.MergeStringMaps(labels, map[string]string{corev1.LabelOSStable: string(corev1.Windows)})
+}
+
+// addOperatingSystemTaint taints the nodes of Windows worker pools unless the pool already defines a taint with the same
+// key, so that only workloads which tolerate Windows are scheduled on them.
+func addOperatingSystemTaint(taints []corev1.Taint, windows bool) []corev1.Taint {
+	if !windows {
+		return taints
+	}
+	for _, taint := range taints {
+		if taint.Key == corev1.LabelOSStable {
+			return taints
+		}
+	}
+	return append(append([]corev1.Taint{}, taints...), corev1.Taint{
+		Key:    corev1.LabelOSStable,
+		Value:  string(corev1.Windows),
+		Effect: corev1.TaintEffectNoSchedule,
+	})
+}
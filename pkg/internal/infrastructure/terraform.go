@@ -152,6 +152,12 @@ func ComputeTerraformerTemplateValues(
 		azureConfig["countUpdateDomains"] = count.updateDomains
 	}
 
+	if migrating, err := IsMigratingToZones(infra, config); err != nil {
+		return nil, err
+	} else if migrating {
+		return nil, fmt.Errorf("the migration of non-zonal clusters to zonal clusters is only supported if the infrastructure is reconciled with flow, use the %q annotation to enable it", azure.AnnotationKeyUseFlow)
+	}
+
 	if config.Identity != nil && config.Identity.Name != "" && config.Identity.ResourceGroup != "" {
 		identityConfig = map[string]interface{}{
 			"name":          config.Identity.Name,
@@ -580,14 +586,11 @@ func IsPrimaryAvailabilitySetRequired(infra *extensionsv1alpha1.Infrastructure,
 		return false, errors.New("cannot determine if primary availability set is required as cluster.Shoot is not set")
 	}
 
-	hasVmoAnnotation := helper.HasShootVmoAlphaAnnotation(cluster.Shoot.Annotations)
+	vmoConfigured := helper.IsVmoConfigured(config, helper.HasShootVmoAlphaAnnotation(cluster.Shoot.Annotations))
 
 	// If the infrastructureStatus is not exists that mean it is a new Infrastucture.
 	if infra.Status.ProviderStatus == nil {
-		if hasVmoAnnotation {
-			return false, nil
-		}
-		return true, nil
+		return !vmoConfigured, nil
 	}
 
 	// If the infrastructureStatus already exists that mean the Infrastucture is already created.
@@ -596,18 +599,33 @@ func IsPrimaryAvailabilitySetRequired(infra *extensionsv1alpha1.Infrastructure,
 		return false, err
 	}
 
+	// The availability set is kept until the cluster is migrated to VMOs. As all worker pools have to be migrated to
+	// VMOs of their own before, it does not contain any machines anymore at this point.
 	if len(infrastructureStatus.AvailabilitySets) > 0 {
 		if _, err := helper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, api.PurposeNodes); err == nil {
-			if hasVmoAnnotation {
-				return false, errors.New("cannot use vmss orchestration mode VM (VMO) as this cluster already used an availability set")
-			}
-			return true, nil
+			return !vmoConfigured, nil
 		}
 	}
 
 	return false, nil
 }
 
+// IsMigratingToZones determines if a non-zonal cluster is migrated to a zonal cluster, i.e. the configuration is zonal
+// while the infrastructure status still contains the availability set of the cluster.
+func IsMigratingToZones(infra *extensionsv1alpha1.Infrastructure, config *api.InfrastructureConfig) (bool, error) {
+	if !config.Zoned || infra.Status.ProviderStatus == nil {
+		return false, nil
+	}
+
+	infrastructureStatus, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = helper.FindAvailabilitySetByPurpose(infrastructureStatus.AvailabilitySets, api.PurposeNodes)
+	return err == nil, nil
+}
+
 func computeSubnetOutputKeys(infra *extensionsv1alpha1.Infrastructure, config *api.InfrastructureConfig) []string {
 	var subnetOutputKeys []string
 
@@ -153,6 +153,24 @@ var _ = Describe("Terraform", func() {
 			Expect(values).To(BeEquivalentTo(expectedValues))
 		})
 
+		It("should refuse to migrate a non-zonal cluster to zones", func() {
+			status := apiv1alpha1.InfrastructureStatus{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "InfrastructureStatus",
+					APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+				},
+				AvailabilitySets: []apiv1alpha1.AvailabilitySet{
+					{Name: "avset", Purpose: apiv1alpha1.PurposeNodes},
+				},
+			}
+			rawStatus, err := json.Marshal(status)
+			Expect(err).To(Not(HaveOccurred()))
+			infra.Status.ProviderStatus = &runtime.RawExtension{Raw: rawStatus}
+
+			_, err = ComputeTerraformerTemplateValues(infra, config, cluster)
+			Expect(err).To(MatchError(ContainSubstring("only supported if the infrastructure is reconciled with flow")))
+		})
+
 		Context("Cluster with primary availabilityset (non zoned)", func() {
 			BeforeEach(func() {
 				config.Zoned = false
@@ -224,6 +242,16 @@ var _ = Describe("Terraform", func() {
 				Expect(values).To(BeEquivalentTo(expectedValues))
 			})
 
+			It("should correctly compute the terraformer chart values for the VMSSFlex orchestration mode", func() {
+				cluster.Shoot.Annotations = nil
+				orchestrationMode := api.OrchestrationModeVMSSFlex
+				config.OrchestrationMode = &orchestrationMode
+
+				values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(values).To(BeEquivalentTo(expectedValues))
+			})
+
 			It("should correctly compute the terraformer chart values with existing infrastrucutre status", func() {
 				infrastructureStatus := apiv1alpha1.InfrastructureStatus{
 					TypeMeta: metav1.TypeMeta{
@@ -247,7 +275,7 @@ var _ = Describe("Terraform", func() {
 				Expect(values).To(BeEquivalentTo(expectedValues))
 			})
 
-			It("should throw an error as cluster already use primary availabilityset", func() {
+			It("should not require the availability set anymore once the cluster is migrated to VMOs", func() {
 				infrastructureStatus := apiv1alpha1.InfrastructureStatus{
 					TypeMeta: metav1.TypeMeta{
 						Kind:       "InfrastructureStatus",
@@ -272,9 +300,9 @@ var _ = Describe("Terraform", func() {
 					},
 				}
 
-				_, err = ComputeTerraformerTemplateValues(infra, config, cluster)
-				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("cannot use vmss orchestration mode VM (VMO) as this cluster already used an availability set"))
+				values, err := ComputeTerraformerTemplateValues(infra, config, cluster)
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(values).To(BeEquivalentTo(expectedValues))
 			})
 		})
 
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StuckMachineThreshold is the time after which a machine which is still in creation is considered to be stuck.
+const StuckMachineThreshold = 10 * time.Minute
+
+// FindStuckMachines returns the machines in the given namespace which are still in creation after the
+// StuckMachineThreshold and whose machine class enables boot diagnostics, so that their serial log can be retrieved.
+// The machines are sorted by name.
+func FindStuckMachines(ctx context.Context, c client.Client, namespace string) ([]machinev1alpha1.Machine, error) {
+	machineClassList := &machinev1alpha1.MachineClassList{}
+	if err := c.List(ctx, machineClassList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list machine classes: %w", err)
+	}
+
+	machineClasses := sets.New[string]()
+	for _, machineClass := range machineClassList.Items {
+		if hasBootDiagnostics(machineClass) {
+			machineClasses.Insert(machineClass.Name)
+		}
+	}
+	if machineClasses.Len() == 0 {
+		return nil, nil
+	}
+
+	machineList := &machinev1alpha1.MachineList{}
+	if err := c.List(ctx, machineList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	var stuckMachines []machinev1alpha1.Machine
+	for _, machine := range machineList.Items {
+		if !machineClasses.Has(machine.Spec.Class.Name) || time.Since(machine.CreationTimestamp.Time) < StuckMachineThreshold {
+			continue
+		}
+		if phase := machine.Status.CurrentStatus.Phase; phase == machinev1alpha1.MachinePending || phase == machinev1alpha1.MachineCrashLoopBackOff {
+			stuckMachines = append(stuckMachines, machine)
+		}
+	}
+
+	sort.Slice(stuckMachines, func(i, j int) bool {
+		return stuckMachines[i].Name < stuckMachines[j].Name
+	})
+	return stuckMachines, nil
+}
+
+func hasBootDiagnostics(machineClass machinev1alpha1.MachineClass) bool {
+	var providerSpec struct {
+		Properties struct {
+			DiagnosticsProfile *struct {
+				BootDiagnostics *struct {
+					Enabled bool `json:"enabled"`
+				} `json:"bootDiagnostics"`
+			} `json:"diagnosticsProfile"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(machineClass.ProviderSpec.Raw, &providerSpec); err != nil {
+		return false
+	}
+	diagnosticsProfile := providerSpec.Properties.DiagnosticsProfile
+	return diagnosticsProfile != nil && diagnosticsProfile.BootDiagnostics != nil && diagnosticsProfile.BootDiagnostics.Enabled
+}
@@ -109,8 +109,9 @@ func NetworkLayoutMigrationMutate(_ context.Context, logger logr.Logger, newInfr
 		return nil
 	}
 
-	// if the old configuration is not using zones or if it is already using a multi-subnet layout, no mutation is necessary.
-	if !oldProviderCfg.Zoned || len(oldProviderCfg.Networks.Zones) > 0 {
+	// if the old configuration is already using a multi-subnet layout, no mutation is necessary. Non-zonal clusters are
+	// considered as well, as they can be migrated to zonal clusters with a multi-subnet layout.
+	if len(oldProviderCfg.Networks.Zones) > 0 {
 		return nil
 	}
 
@@ -90,6 +90,18 @@ var _ = Describe("Mutate", func() {
 				Expect(ok).To(BeTrue())
 				Expect(v).To(Equal("2"))
 			})
+			It("should mutate the resource when migrating a non-zonal cluster to zones", func() {
+				workersConfig.Zoned = false
+				oldInfra := generateInfrastructureWithProviderConfig(workersConfig, nil)
+				newInfra := generateInfrastructureWithProviderConfig(zonesConfig, nil)
+
+				err := mutator.Mutate(context.TODO(), newInfra, oldInfra)
+
+				Expect(err).To(BeNil())
+				v, ok := getLayoutMigrationAnnotation(newInfra)
+				Expect(ok).To(BeTrue())
+				Expect(v).To(Equal("2"))
+			})
 			It("should do nothing if network setup stays the same", func() {
 				newInfra := generateInfrastructureWithProviderConfig(workersConfig, nil)
 